@@ -0,0 +1,151 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// NIDs are an IPv4 or IPv6 address followed by the LNet network, e.g. '10.20.0.1@o2ib1'.
+	nidRegexPattern = regexp.MustCompile(`\b((?:[0-9]{1,3}\.){3}[0-9]{1,3}|[0-9a-fA-F]*:[0-9a-fA-F:]+)@([a-z][a-z0-9]*)\b`)
+	// Jobids are given by the 'job_id' key of the job statistics and may contain spaces, so the rest of the line is replaced.
+	jobidRegexPattern = regexp.MustCompile(`(?m)(job_id:[ \t]+)(.*)$`)
+)
+
+// captureRedactor replaces the NIDs and jobids of a capture by pseudonyms.
+// The same NID or jobid is always replaced by the same pseudonym, so the relations between the files are kept.
+type captureRedactor struct {
+	nids   map[string]string
+	jobids map[string]string
+}
+
+func newCaptureRedactor(redactNIDs bool, redactJobids bool) *captureRedactor {
+	r := &captureRedactor{}
+	if redactNIDs {
+		r.nids = map[string]string{}
+	}
+	if redactJobids {
+		r.jobids = map[string]string{}
+	}
+	return r
+}
+
+// redactNIDs replaces the addresses of the NIDs by addresses within 10.0.0.0/8, keeping the LNet network.
+func (r *captureRedactor) redactNIDs(text string) string {
+	if r.nids == nil {
+		return text
+	}
+	return nidRegexPattern.ReplaceAllStringFunc(text, func(nid string) string {
+		match := nidRegexPattern.FindStringSubmatch(nid)
+		address, exists := r.nids[match[1]]
+		if !exists {
+			n := len(r.nids) + 1
+			address = fmt.Sprintf("10.%d.%d.%d", n>>16&0xff, n>>8&0xff, n&0xff)
+			r.nids[match[1]] = address
+		}
+		return address + "@" + match[2]
+	})
+}
+
+func (r *captureRedactor) redactJobids(text string) string {
+	if r.jobids == nil {
+		return text
+	}
+	return jobidRegexPattern.ReplaceAllStringFunc(text, func(line string) string {
+		match := jobidRegexPattern.FindStringSubmatch(line)
+		jobid, exists := r.jobids[match[2]]
+		if !exists {
+			jobid = fmt.Sprintf("job%d", len(r.jobids)+1)
+			r.jobids[match[2]] = jobid
+		}
+		return match[1] + jobid
+	})
+}
+
+// redact returns the file with the NIDs redacted in its name and content and the jobids redacted in its content.
+func (r *captureRedactor) redact(file sources.CaptureFile) sources.CaptureFile {
+	if r.nids == nil && r.jobids == nil {
+		return file
+	}
+	return sources.CaptureFile{
+		Name:    r.redactNIDs(file.Name),
+		Content: []byte(r.redactJobids(r.redactNIDs(string(file.Content)))),
+	}
+}
+
+// runCapture captures the input of the sources into the archive.
+// Files which cannot be read are logged and left out of the archive.
+func runCapture(ctx context.Context, paths sources.Paths, sourceList map[string]sources.LustreSource, archive string, redactor *captureRedactor) error {
+	var list []sources.LustreSource
+	for _, source := range sourceList {
+		list = append(list, source)
+	}
+	files, err := sources.CaptureNode(ctx, paths, list)
+	if err != nil {
+		log.Warnf("Unable to capture all files: %s", err)
+	}
+	for i, file := range files {
+		files[i] = redactor.redact(file)
+	}
+	if err := writeCaptureArchive(archive, files); err != nil {
+		return fmt.Errorf("unable to write capture archive %s: %s", archive, err)
+	}
+	log.Infof("Captured %d files into %s", len(files), archive)
+	return nil
+}
+
+// writeCaptureArchive writes the files into a .tar.gz archive.
+// The archive is written to a temporary file, which replaces the archive once it is complete.
+func writeCaptureArchive(archive string, files []sources.CaptureFile) error {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(archive), "."+filepath.Base(archive))
+	if err != nil {
+		return err
+	}
+	// The temporary file is gone after the rename
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	gzipWriter := gzip.NewWriter(tmpFile)
+	tarWriter := tar.NewWriter(gzipWriter)
+	now := time.Now()
+	for _, file := range files {
+		header := &tar.Header{
+			Name:     file.Name,
+			Mode:     0644,
+			Size:     int64(len(file.Content)),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			_ = tmpFile.Close()
+			return err
+		}
+		if _, err := tarWriter.Write(file.Content); err != nil {
+			_ = tmpFile.Close()
+			return err
+		}
+	}
+	for _, closer := range []interface{ Close() error }{tarWriter, gzipWriter, tmpFile} {
+		if err := closer.Close(); err != nil {
+			return err
+		}
+	}
+	return os.Rename(tmpFile.Name(), archive)
+}
@@ -0,0 +1,86 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+)
+
+func TestCaptureReplay(t *testing.T) {
+	expected := gatherSampleNames(t, fixturePaths)
+
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, fixtureConfig())
+	if errList != nil {
+		t.Fatal(errList)
+	}
+	archive := filepath.Join(dir, "node.tar.gz")
+	if err := runCapture(context.Background(), fixturePaths, sourceList, archive, newCaptureRedactor(false, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	replayDir := filepath.Join(dir, "replay")
+	if err := extractReplayArchive(archive, replayDir); err != nil {
+		t.Fatal(err)
+	}
+	if samples := gatherSampleNames(t, replayPaths(replayDir)); !reflect.DeepEqual(samples, expected) {
+		t.Fatalf("Retrieved unexpected samples from the captured archive. Expected: %v, Got: %v", expected, samples)
+	}
+}
+
+func TestCaptureRedactor(t *testing.T) {
+	redactor := newCaptureRedactor(true, true)
+	file := redactor.redact(sources.CaptureFile{
+		Name: "proc/fs/lustre/obdfilter/lustrefs-OST0000/exports/192.168.1.20@o2ib1/stats",
+		Content: []byte(`job_stats:
+- job_id:          dd.1000
+  snapshot_time:   1638540802
+- job_id:          cat.1001
+  snapshot_time:   1638540802
+- job_id:          dd.1000
+- job_id:          srun job 1002
+  snapshot_time:   1638540802
+nid: 192.168.1.21@o2ib1 fe80::1@tcp 192.168.1.20@o2ib1 0@lo
+`),
+	})
+
+	expectedName := "proc/fs/lustre/obdfilter/lustrefs-OST0000/exports/10.0.0.1@o2ib1/stats"
+	if file.Name != expectedName {
+		t.Fatalf("Retrieved an unexpected name. Expected: %s, Got: %s", expectedName, file.Name)
+	}
+	expectedContent := `job_stats:
+- job_id:          job1
+  snapshot_time:   1638540802
+- job_id:          job2
+  snapshot_time:   1638540802
+- job_id:          job1
+- job_id:          job3
+  snapshot_time:   1638540802
+nid: 10.0.0.2@o2ib1 10.0.0.3@tcp 10.0.0.1@o2ib1 0@lo
+`
+	if content := string(file.Content); content != expectedContent {
+		t.Fatalf("Retrieved unexpected content. Expected: %q, Got: %q", expectedContent, content)
+	}
+
+	file = newCaptureRedactor(false, false).redact(sources.CaptureFile{Name: "proc/mounts", Content: []byte("192.168.1.20@o2ib1:/lustrefs")})
+	if string(file.Content) != "192.168.1.20@o2ib1:/lustrefs" {
+		t.Fatalf("Redacted content without redaction: %s", file.Content)
+	}
+}
@@ -0,0 +1,114 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"gopkg.in/yaml.v2"
+)
+
+// exporterConfig is the configuration file given by --config.file.
+type exporterConfig struct {
+	Collectors map[string]collectorConfig `yaml:"collectors"`
+	Metrics    []metricRuleConfig         `yaml:"metrics"`
+}
+
+// collectorConfig contains the options of a collector, unset options keep the value of their flag.
+type collectorConfig struct {
+	Level      string `yaml:"level"`
+	PerProcess *bool  `yaml:"per_process"`
+}
+
+type metricRuleConfig struct {
+	Collector string `yaml:"collector"`
+	Metric    string `yaml:"metric"`
+	File      string `yaml:"file"`
+	Enabled   *bool  `yaml:"enabled"`
+}
+
+// collectorLevelSettings maps the collector names of the configuration file to their level within the sources config.
+var collectorLevelSettings = map[string]func(config *sources.Config) *string{
+	"ost":     func(config *sources.Config) *string { return &config.Ost },
+	"mdt":     func(config *sources.Config) *string { return &config.Mdt },
+	"mgs":     func(config *sources.Config) *string { return &config.Mgs },
+	"mds":     func(config *sources.Config) *string { return &config.Mds },
+	"client":  func(config *sources.Config) *string { return &config.Client },
+	"generic": func(config *sources.Config) *string { return &config.Generic },
+	"lnet":    func(config *sources.Config) *string { return &config.Lnet },
+	"health":  func(config *sources.Config) *string { return &config.Health },
+}
+
+// loadConfig reads and validates the configuration file, unknown keys are rejected.
+func loadConfig(path string) (*exporterConfig, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var config exporterConfig
+	if err := yaml.UnmarshalStrict(content, &config); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %s", path, err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration %s: %s", path, err)
+	}
+	return &config, nil
+}
+
+func (c *exporterConfig) validate() error {
+	for name, collector := range c.Collectors {
+		if _, exists := collectorLevelSettings[name]; !exists {
+			return fmt.Errorf("collectors: unknown collector %q", name)
+		}
+		switch collector.Level {
+		case "", "extended", "core", "disabled":
+		default:
+			return fmt.Errorf("collectors.%s: invalid level %q, valid levels: [extended, core, disabled]", name, collector.Level)
+		}
+		if collector.PerProcess != nil && name != "client" {
+			return fmt.Errorf("collectors.%s: per_process is only supported by the client collector", name)
+		}
+	}
+	for i, rule := range c.Metrics {
+		if rule.Enabled == nil {
+			return fmt.Errorf("metrics[%d]: missing enabled", i)
+		}
+		if err := rule.metricRule().Validate(); err != nil {
+			return fmt.Errorf("metrics[%d]: %s", i, err)
+		}
+	}
+	return nil
+}
+
+func (r metricRuleConfig) metricRule() sources.MetricRule {
+	rule := sources.MetricRule{Collector: r.Collector, Metric: r.Metric, File: r.File}
+	if r.Enabled != nil {
+		rule.Enabled = *r.Enabled
+	}
+	return rule
+}
+
+// apply overrides the settings of the sources config given by the flags with the ones of the configuration file.
+func (c *exporterConfig) apply(config *sources.Config) {
+	for name, collector := range c.Collectors {
+		if collector.Level != "" {
+			*collectorLevelSettings[name](config) = collector.Level
+		}
+		if collector.PerProcess != nil {
+			config.ClientPerProcess = *collector.PerProcess
+		}
+	}
+	config.MetricRules = nil
+	for _, rule := range c.Metrics {
+		config.MetricRules = append(config.MetricRules, rule.metricRule())
+	}
+}
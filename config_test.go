@@ -0,0 +1,98 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+)
+
+func writeConfig(t *testing.T, dir string, content string) string {
+	path := filepath.Join(dir, "config.yml")
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config, err := loadConfig(writeConfig(t, dir, `
+collectors:
+  ost:
+    level: extended
+  client:
+    level: core
+    per_process: true
+metrics:
+  - collector: generic
+    file: encrypt_page_pools
+    enabled: false
+  - collector: mdt
+    metric: lustre_job_*
+    enabled: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sourcesConfig := sources.DefaultConfig()
+	sourcesConfig.Ost, sourcesConfig.Client, sourcesConfig.ClientPerProcess = "core", "extended", false
+	config.apply(&sourcesConfig)
+
+	if sourcesConfig.Ost != "extended" || sourcesConfig.Client != "core" || !sourcesConfig.ClientPerProcess {
+		t.Fatalf("Unexpected collector settings: ost=%s client=%s per-process=%t", sourcesConfig.Ost, sourcesConfig.Client, sourcesConfig.ClientPerProcess)
+	}
+	expected := []sources.MetricRule{
+		{Collector: "generic", File: "encrypt_page_pools", Enabled: false},
+		{Collector: "mdt", Metric: "lustre_job_*", Enabled: true},
+	}
+	if !reflect.DeepEqual(sourcesConfig.MetricRules, expected) {
+		t.Fatalf("Retrieved unexpected metric rules. Expected: %+v, Got: %+v", expected, sourcesConfig.MetricRules)
+	}
+}
+
+func TestLoadInvalidConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := map[string]string{
+		"collectors:\n  oss:\n    level: core\n":                                                      `unknown collector "oss"`,
+		"collectors:\n  ost:\n    level: full\n":                                                      `invalid level "full"`,
+		"collectors:\n  ost:\n    per_process: true\n":                                                "per_process is only supported by the client collector",
+		"collectors:\n  ost:\n    levle: core\n":                                                      "field levle not found",
+		"metrics:\n  - metric: job_*\n":                                                               "metrics[0]: missing enabled",
+		"metrics:\n  - enabled: false\n":                                                              "metrics[0]: rule without a collector, metric or file selector",
+		"metrics:\n  - file: job_stats\n    enabled: false\n  - metric: '[job'\n    enabled: false\n": `metrics[1]: invalid pattern "[job"`,
+		"metrics:\n  - collector: ost\n    file: encrypt_page_pools\n    enabled: false\n":            "metrics[0]: rule matches no metric of the collectors",
+	}
+	for content, message := range tests {
+		_, err := loadConfig(writeConfig(t, dir, content))
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Fatalf("Expected an error containing %q for %q, Got: %v", message, content, err)
+		}
+	}
+	if _, err := loadConfig(filepath.Join(dir, "missing.yml")); err == nil {
+		t.Fatal("Expected an error loading a missing configuration file")
+	}
+}
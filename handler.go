@@ -0,0 +1,140 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"fmt"
+	stdlog "log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// collectParam is the URL parameter selecting the collectors of a scrape, e.g. 'collect[]=ost&collect[]=jobstats'.
+	collectParam = "collect[]"
+	// scrapeTimeoutHeader is the header Prometheus sends with the timeout of a scrape in seconds.
+	scrapeTimeoutHeader = "X-Prometheus-Scrape-Timeout-Seconds"
+	// scrapeTimeoutOffset is subtracted from the timeout of Prometheus, so the response is sent before it gives up.
+	scrapeTimeoutOffset = 500 * time.Millisecond
+)
+
+// selectableCollector is a collector able to collect the metrics of selected collectors only.
+type selectableCollector interface {
+	prometheus.Collector
+	collectors() []string
+	collectContext(ctx context.Context, ch chan<- prometheus.Metric)
+	collectSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection)
+}
+
+// selectedCollector collects the metrics of the collectors selected by a scrape until the scrape is done.
+// A nil selection collects all metrics including the self-metrics.
+type selectedCollector struct {
+	ctx       context.Context
+	collector selectableCollector
+	selection sources.Selection
+}
+
+// Describe implements the prometheus.Describe interface
+func (s selectedCollector) Describe(ch chan<- *prometheus.Desc) {
+	s.collector.Describe(ch)
+}
+
+// Collect implements the prometheus.Collect interface
+func (s selectedCollector) Collect(ch chan<- prometheus.Metric) {
+	if s.selection == nil {
+		s.collector.collectContext(s.ctx, ch)
+		return
+	}
+	s.collector.collectSelected(s.ctx, ch, s.selection)
+}
+
+// scrapeTimeout returns the timeout of the scrape, which is the lower one of the timeout given by the flag
+// and the one sent by Prometheus, zero for none.
+func scrapeTimeout(r *http.Request, timeout time.Duration) time.Duration {
+	if header := r.Header.Get(scrapeTimeoutHeader); header != "" {
+		seconds, err := strconv.ParseFloat(header, 64)
+		if err != nil {
+			log.Debugf("Ignoring invalid scrape timeout %q: %s", header, err)
+			return timeout
+		}
+		requestTimeout := time.Duration(seconds * float64(time.Second))
+		if requestTimeout > scrapeTimeoutOffset {
+			requestTimeout -= scrapeTimeoutOffset
+		}
+		if requestTimeout > 0 && (timeout <= 0 || requestTimeout < timeout) {
+			return requestTimeout
+		}
+	}
+	return timeout
+}
+
+// newMetricsHandler returns the handler of the metrics path, which collects the sources until the request is done
+// or the scrape timed out, see scrapeTimeout.
+// Scrapes without collect[] parameters collect all metrics including the ones of the gatherer,
+// the others collect the selected collectors of the loaded sources only.
+func newMetricsHandler(collector selectableCollector, gatherer prometheus.Gatherer, timeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names := r.URL.Query()[collectParam]
+		valid := collector.collectors()
+		var selection sources.Selection
+		if len(names) > 0 {
+			selection = sources.Selection{}
+		}
+		var unknown []string
+		for _, name := range names {
+			if indexOf(valid, name) < 0 {
+				unknown = append(unknown, name)
+				continue
+			}
+			selection[name] = true
+		}
+		if unknown != nil {
+			http.Error(w, fmt.Sprintf("unknown collectors: %s, valid collectors: %s", strings.Join(unknown, ", "), strings.Join(valid, ", ")), http.StatusBadRequest)
+			return
+		}
+
+		ctx := r.Context()
+		if timeout := scrapeTimeout(r, timeout); timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		registry := prometheus.NewRegistry()
+		if err := registry.Register(selectedCollector{ctx: ctx, collector: collector, selection: selection}); err != nil {
+			http.Error(w, fmt.Sprintf("unable to register the selected collectors: %s", err), http.StatusInternalServerError)
+			return
+		}
+		var gatherers prometheus.Gatherers
+		if selection == nil {
+			gatherers = append(gatherers, gatherer)
+		}
+		gatherers = append(gatherers, registry)
+		promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{
+			ErrorLog: stdlog.New(os.Stderr, "", stdlog.LstdFlags)}).ServeHTTP(w, r)
+	})
+}
+
+func indexOf(list []string, s string) int {
+	for i, element := range list {
+		if element == s {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,107 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestMetricsHandlerSelection(t *testing.T) {
+	config := fixtureConfig()
+	config.Ost = "disabled"
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+	gatherer := prometheus.NewRegistry()
+	gatherer.MustRegister(prometheus.NewGauge(prometheus.GaugeOpts{Name: "default_test", Help: "Test"}))
+	server := httptest.NewServer(newMetricsHandler(LustreSource{sourceList: sourceList}, gatherer, time.Minute))
+	defer server.Close()
+
+	get := func(query string) (int, string) {
+		resp, err := http.Get(server.URL + "/metrics" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.StatusCode, string(body)
+	}
+
+	status, body := get("")
+	if status != http.StatusOK || !strings.Contains(body, "default_test") || !strings.Contains(body, "lustre_job_stats_total") ||
+		!strings.Contains(body, "lustre_exporter_scrape_duration_seconds") {
+		t.Fatalf("Expected all metrics without collect[] parameters, Got: %d %q", status, body)
+	}
+
+	status, body = get("?collect[]=jobstats")
+	if status != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %s", status, body)
+	}
+	for _, line := range strings.Split(body, "\n") {
+		if line != "" && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "lustre_job_") {
+			t.Fatalf("Retrieved a metric not selected by collect[]=jobstats: %s", line)
+		}
+	}
+	if !strings.Contains(body, `lustre_job_stats_total{component="mdt"`) {
+		t.Fatalf("Retrieved no MDT job stats with collect[]=jobstats: %s", body)
+	}
+
+	status, body = get("?collect[]=mdt&collect[]=health")
+	if status != http.StatusOK {
+		t.Fatalf("Unexpected status %d: %s", status, body)
+	}
+	if strings.Contains(body, "lustre_job_") || !strings.Contains(body, "lustre_health_check") || !strings.Contains(body, `component="mdt"`) {
+		t.Fatalf("Retrieved unexpected metrics with collect[]=mdt&collect[]=health: %s", body)
+	}
+
+	status, body = get("?collect[]=ost&collect[]=jobstats&collect[]=foo")
+	if status != http.StatusBadRequest {
+		t.Fatalf("Expected status %d for unknown collectors, Got: %d", http.StatusBadRequest, status)
+	}
+	expected := "unknown collectors: ost, foo, valid collectors: client, generic, health, jobstats, lnet, mdt, mgs"
+	if !strings.Contains(body, expected) {
+		t.Fatalf("Retrieved an unexpected error. Expected: %q, Got: %q", expected, body)
+	}
+}
+
+func TestScrapeTimeout(t *testing.T) {
+	tests := []struct {
+		header   string
+		timeout  time.Duration
+		expected time.Duration
+	}{
+		{"", time.Minute, time.Minute},
+		{"", 0, 0},
+		{"10", time.Minute, 9500 * time.Millisecond},
+		{"10", 5 * time.Second, 5 * time.Second},
+		{"10", 0, 9500 * time.Millisecond},
+		{"0.2", 0, 200 * time.Millisecond},
+		{"invalid", time.Minute, time.Minute},
+	}
+	for _, test := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+		if test.header != "" {
+			r.Header.Set(scrapeTimeoutHeader, test.header)
+		}
+		if timeout := scrapeTimeout(r, test.timeout); timeout != test.expected {
+			t.Fatalf("Unexpected scrape timeout for header %q and flag %s. Expected: %s, Got: %s", test.header, test.timeout, test.expected, timeout)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BRWStats is a 'brw_stats' file of an OST or a 'rpc_stats' file of an OSC or MDC, containing histograms of the RPCs and I/Os.
+type BRWStats struct {
+	// SnapshotTime is the time the file was read
+	SnapshotTime time.Time
+	// Histograms are the histograms in the order of the file
+	Histograms []BRWHistogram
+}
+
+// BRWHistogram is a histogram of a 'brw_stats' or 'rpc_stats' file, e.g. 'pages per bulk r/w'.
+type BRWHistogram struct {
+	// Name is the name of the histogram without its unit, e.g. 'disk I/O size' or 'I/O time (1/1000s)'
+	Name string
+	// Operations are the names of the columns, e.g. 'read' and 'write', or 'modify' in the 'rpc_stats' file of a MDC.
+	// They are nil if the file does not name them.
+	Operations []string
+	Buckets    []BRWBucket
+}
+
+// BRWBucket is a bucket of a histogram.
+type BRWBucket struct {
+	// Size is the lower bound of the bucket as given in the file, e.g. '4K'
+	Size string
+	// Counts are the counts of the bucket by column, e.g. the read and write RPCs
+	Counts []uint64
+}
+
+// ParseBRWStats parses a 'brw_stats' or 'rpc_stats' file. The histograms are in the following format:
+//
+//	                           read      |     write
+//	pages per bulk r/w     rpcs  % cum % |  rpcs        % cum %
+//	1:		        13  56  56   |  153   0   0
+//	2:		        10  43 100   |  157   0   0
+func ParseBRWStats(r io.Reader) (BRWStats, error) {
+	var stats BRWStats
+	var histogram *BRWHistogram
+	var previous []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			histogram = nil
+			previous = nil
+			continue
+		}
+		if key, value, ok := splitKeyValue(line); ok && key == "snapshot_time" && value != "" {
+			var err error
+			stats.SnapshotTime, err = parseTime(strings.Fields(value)[0])
+			if err != nil {
+				return BRWStats{}, fmt.Errorf("snapshot_time: %s", err)
+			}
+			continue
+		}
+		if name, ok := brwHistogramName(fields); ok {
+			stats.Histograms = append(stats.Histograms, BRWHistogram{Name: name, Operations: brwOperations(previous)})
+			histogram = &stats.Histograms[len(stats.Histograms)-1]
+			continue
+		}
+		previous = fields
+		if histogram == nil || !strings.HasSuffix(fields[0], ":") {
+			continue
+		}
+		bucket, err := parseBRWBucket(line)
+		if err != nil {
+			return BRWStats{}, fmt.Errorf("%s: %s", histogram.Name, err)
+		}
+		histogram.Buckets = append(histogram.Buckets, bucket)
+	}
+	return stats, scanner.Err()
+}
+
+// brwHistogramName returns the name of the histogram if the fields are the header of a histogram,
+// which is followed by the columns of each operation, e.g. 'rpcs % cum %'.
+func brwHistogramName(fields []string) (string, bool) {
+	for i := 1; i+2 < len(fields); i++ {
+		if fields[i+1] == "%" && fields[i+2] == "cum" {
+			return strings.Join(fields[:i], " "), true
+		}
+	}
+	return "", false
+}
+
+// brwOperations returns the names of the operations given by the line preceding the header of a histogram,
+// e.g. 'read | write'. Lines with a colon are values like 'read RPCs in flight: 0' instead.
+func brwOperations(fields []string) []string {
+	var operations []string
+	for _, field := range fields {
+		if strings.Contains(field, ":") {
+			return nil
+		}
+		if field != "|" {
+			operations = append(operations, field)
+		}
+	}
+	return operations
+}
+
+// parseBRWBucket parses a bucket of a histogram, e.g. '1K: 0 0 100 | 4059303 94 100' with the count,
+// the percentage and the cumulative percentage of each operation.
+func parseBRWBucket(line string) (BRWBucket, error) {
+	i := strings.Index(line, ":")
+	bucket := BRWBucket{Size: strings.TrimSpace(line[:i])}
+	for _, column := range strings.Split(line[i+1:], "|") {
+		fields := strings.Fields(column)
+		if len(fields) == 0 {
+			continue
+		}
+		count, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return BRWBucket{}, err
+		}
+		bucket.Counts = append(bucket.Counts, count)
+	}
+	return bucket, nil
+}
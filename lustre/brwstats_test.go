@@ -0,0 +1,94 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseBRWStats(t *testing.T) {
+	testBRWStats := `snapshot_time:         1510782606.797216394 (secs.nsecs)
+
+                           read      |     write
+pages per bulk r/w     rpcs  % cum % |  rpcs        % cum %
+1:		        13  56  56   |  153   0   0
+2:		        10  43 100   |  157   0   0
+
+                           read      |     write
+disk I/O size          ios   % cum % |  ios         % cum %
+4K:		        23 100 100   |  153   0   0
+1M:		         0   0 100   | 4059303  99 100`
+
+	stats, err := ParseBRWStats(strings.NewReader(testBRWStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := BRWStats{
+		SnapshotTime: time.Unix(1510782606, 797216394),
+		Histograms: []BRWHistogram{
+			{Name: "pages per bulk r/w", Operations: []string{"read", "write"}, Buckets: []BRWBucket{
+				{Size: "1", Counts: []uint64{13, 153}},
+				{Size: "2", Counts: []uint64{10, 157}},
+			}},
+			{Name: "disk I/O size", Operations: []string{"read", "write"}, Buckets: []BRWBucket{
+				{Size: "4K", Counts: []uint64{23, 153}},
+				{Size: "1M", Counts: []uint64{0, 4059303}},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Fatalf("Retrieved unexpected brw_stats. Expected: %+v, Got: %+v", expected, stats)
+	}
+}
+
+func TestParseBRWStatsModifyRPCs(t *testing.T) {
+	testRPCStats := `snapshot_time:         1510950459.783304874 (secs.nsecs)
+read RPCs in flight:  0
+write RPCs in flight: 0
+
+			read			write
+rpcs in flight        rpcs   % cum % |       rpcs   % cum %
+0:		         0   0   0   |          0   0   0
+
+modify_RPCs_in_flight:  0
+
+			modify
+rpcs in flight        rpcs   % cum %
+0:		         0   0   0
+1:		        90  45  45`
+
+	stats, err := ParseBRWStats(strings.NewReader(testRPCStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []BRWHistogram{
+		{Name: "rpcs in flight", Operations: []string{"read", "write"}, Buckets: []BRWBucket{
+			{Size: "0", Counts: []uint64{0, 0}},
+		}},
+		{Name: "rpcs in flight", Operations: []string{"modify"}, Buckets: []BRWBucket{
+			{Size: "0", Counts: []uint64{0}},
+			{Size: "1", Counts: []uint64{90}},
+		}},
+	}
+	if !reflect.DeepEqual(stats.Histograms, expected) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expected, stats.Histograms)
+	}
+
+	// The histograms of a 'rpc_stats' file without operation names have no operations
+	stats, err = ParseBRWStats(strings.NewReader("rpcs in flight: 3\nrpcs in flight        rpcs   % cum %\n0: 0 0 0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(stats.Histograms); l != 1 || stats.Histograms[0].Operations != nil {
+		t.Fatalf("Retrieved unexpected histograms: %+v", stats.Histograms)
+	}
+}
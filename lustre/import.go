@@ -0,0 +1,85 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"io"
+	"strings"
+	"time"
+)
+
+// Import is an 'import' file of an OSC, MDC or MGC, describing the connection to its target.
+// Values not given by the Lustre version are zero.
+type Import struct {
+	// Name is the name of the import, e.g. 'lustrefs-OST0000-osc-ffff88105db50000'
+	Name string
+	// Target is the UUID of the target, e.g. 'lustrefs-OST0000_UUID'
+	Target string
+	// State is the state of the connection, e.g. 'FULL' or 'DISCONN'
+	State         string
+	ConnectFlags  []string
+	ImportFlags   []string
+	TargetVersion string
+
+	FailoverNIDs            []string
+	CurrentConnection       string
+	ConnectionAttempts      uint64
+	Generation              uint64
+	InProgressInvalidations uint64
+
+	RPCsInflight      uint64
+	RPCsUnregistering uint64
+	RPCsTimeouts      uint64
+	AvgWaitTime       time.Duration
+
+	ServiceEstimate time.Duration
+	NetworkEstimate time.Duration
+
+	// Values contains all values of the file by their dotted keys, e.g. 'connect_data.instance'
+	Values map[string]string
+}
+
+// ParseImport parses an 'import' file.
+func ParseImport(r io.Reader) (Import, error) {
+	values, err := parseValues(r)
+	if err != nil {
+		return Import{}, err
+	}
+	// The values are nested below 'import'
+	trimmed := make(map[string]string, len(values))
+	for key, value := range values {
+		trimmed[strings.TrimPrefix(key, "import.")] = value
+	}
+	values = trimmed
+	p := valueParser{values: values}
+	i := Import{
+		Name:                    p.string("name"),
+		Target:                  p.string("target"),
+		State:                   p.string("state"),
+		ConnectFlags:            p.list("connect_flags"),
+		ImportFlags:             p.list("import_flags"),
+		TargetVersion:           p.string("connect_data.target_version"),
+		FailoverNIDs:            p.list("connection.failover_nids"),
+		CurrentConnection:       p.string("connection.current_connection"),
+		ConnectionAttempts:      p.uint("connection.connection_attempts"),
+		Generation:              p.uint("connection.generation"),
+		InProgressInvalidations: p.uint("connection.in-progress_invalidations"),
+		RPCsInflight:            p.uint("rpcs.inflight"),
+		RPCsUnregistering:       p.uint("rpcs.unregistering"),
+		RPCsTimeouts:            p.uint("rpcs.timeouts"),
+		AvgWaitTime:             p.duration("rpcs.avg_waittime"),
+		ServiceEstimate:         p.duration("service_estimates.services"),
+		NetworkEstimate:         p.duration("service_estimates.network"),
+		Values:                  values,
+	}
+	if p.err != nil {
+		return Import{}, p.err
+	}
+	return i, nil
+}
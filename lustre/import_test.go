@@ -0,0 +1,75 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseImport(t *testing.T) {
+	testImport := `import:
+    name: lustrefs-OST0004-osc-ffff88105db50000
+    target: lustrefs-OST0004_UUID
+    state: FULL
+    connect_flags: [ write_grant, server_lock, version ]
+    connect_data:
+       flags: 0x20405af0e3440478
+       instance: 3
+       target_version: 2.10.1.0
+    import_flags: [ replayable, pingable, connect_tried ]
+    connection:
+       failover_nids: [ 172.20.20.5@o2ib, 172.20.20.6@o2ib ]
+       current_connection: 172.20.20.5@o2ib
+       connection_attempts: 1
+       generation: 1
+       in-progress_invalidations: 0
+    rpcs:
+       inflight: 0
+       unregistering: 0
+       timeouts: 2
+       avg_waittime: 331 usec
+    service_estimates:
+       services: 1 sec
+       network: 1 sec
+    transactions:
+       last_replay: 0`
+
+	i, err := ParseImport(strings.NewReader(testImport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.Name != "lustrefs-OST0004-osc-ffff88105db50000" || i.Target != "lustrefs-OST0004_UUID" || i.State != "FULL" {
+		t.Fatalf("Retrieved an unexpected import: %+v", i)
+	}
+	if expected := []string{"write_grant", "server_lock", "version"}; !reflect.DeepEqual(i.ConnectFlags, expected) {
+		t.Fatalf("Retrieved unexpected connect flags. Expected: %v, Got: %v", expected, i.ConnectFlags)
+	}
+	if expected := []string{"172.20.20.5@o2ib", "172.20.20.6@o2ib"}; !reflect.DeepEqual(i.FailoverNIDs, expected) {
+		t.Fatalf("Retrieved unexpected failover NIDs. Expected: %v, Got: %v", expected, i.FailoverNIDs)
+	}
+	if i.TargetVersion != "2.10.1.0" || i.CurrentConnection != "172.20.20.5@o2ib" || i.ConnectionAttempts != 1 || i.RPCsTimeouts != 2 {
+		t.Fatalf("Retrieved an unexpected import: %+v", i)
+	}
+	if expected := 331 * time.Microsecond; i.AvgWaitTime != expected {
+		t.Fatalf("Retrieved an unexpected average wait time. Expected: %v, Got: %v", expected, i.AvgWaitTime)
+	}
+	if i.ServiceEstimate != time.Second || i.NetworkEstimate != time.Second {
+		t.Fatalf("Retrieved unexpected service estimates. Expected: %v, Got: %v and %v", time.Second, i.ServiceEstimate, i.NetworkEstimate)
+	}
+	if value := i.Values["transactions.last_replay"]; value != "0" {
+		t.Fatalf("Retrieved an unexpected value of transactions.last_replay. Expected: %s, Got: %s", "0", value)
+	}
+
+	if _, err := ParseImport(strings.NewReader("import:\n    rpcs:\n       inflight: many")); err == nil {
+		t.Fatal("An error was expected for an invalid value, but not received")
+	}
+}
@@ -0,0 +1,139 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JobStats are the statistics of a single job within a 'job_stats' file of an OST or MDT.
+type JobStats struct {
+	// JobID is the jobid of the job, which is empty if Lustre was unable to determine it
+	JobID string
+	// SnapshotTime is the time the statistics of the job were last updated
+	SnapshotTime time.Time
+	// StartTime is the time the statistics of the job were started, zero if not given by older Lustre versions
+	StartTime time.Time
+	// Counters are the counters by name, e.g. 'read_bytes' or 'open'
+	Counters map[string]Counter
+}
+
+// ParseJobStats parses the jobs of a 'job_stats' file. The jobs are in the following format:
+//
+//	job_stats:
+//	- job_id:          dd.1000
+//	  snapshot_time:   1510950459
+//	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
+//	  getattr:         { samples:           7, unit:  reqs }
+func ParseJobStats(r io.Reader) ([]JobStats, error) {
+	var jobs []JobStats
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(strings.TrimPrefix(line, "-"))
+		key, value, ok := splitKeyValue(line)
+		if !ok {
+			continue
+		}
+		if key == "job_id" {
+			jobs = append(jobs, JobStats{JobID: value, Counters: map[string]Counter{}})
+			continue
+		}
+		if len(jobs) == 0 || value == "" {
+			continue
+		}
+		job := &jobs[len(jobs)-1]
+		var err error
+		switch {
+		case key == "snapshot_time":
+			job.SnapshotTime, err = parseTime(strings.Fields(value)[0])
+		case key == "start_time":
+			job.StartTime, err = parseTime(strings.Fields(value)[0])
+		case strings.HasPrefix(value, "{"):
+			job.Counters[key], err = parseJobCounter(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("job %q: %s: %s", job.JobID, key, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// parseJobCounter parses a counter of a 'job_stats' file, e.g. '{ samples: 1, unit: bytes, min: 4096, max: 4096, sum: 4096 }'.
+// The histograms of newer Lustre versions are skipped.
+func parseJobCounter(value string) (Counter, error) {
+	var counter Counter
+	for _, element := range splitTopLevel(strings.TrimSuffix(strings.TrimPrefix(value, "{"), "}")) {
+		key, value, ok := splitKeyValue(element)
+		if !ok {
+			continue
+		}
+		var field *uint64
+		switch key {
+		case "samples":
+			field = &counter.Samples
+		case "min":
+			field = &counter.Min
+		case "max":
+			field = &counter.Max
+		case "sum":
+			field = &counter.Sum
+		case "sumsq":
+			field = &counter.SumSquares
+		case "unit":
+			counter.Unit = value
+		}
+		if field == nil {
+			continue
+		}
+		var err error
+		*field, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return Counter{}, err
+		}
+	}
+	return counter, nil
+}
+
+// splitTopLevel splits the comma separated elements, which are not nested within braces.
+func splitTopLevel(text string) []string {
+	var elements []string
+	depth, start := 0, 0
+	for i, c := range text {
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				elements = append(elements, text[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(elements, text[start:])
+}
+
+// splitKeyValue splits a 'key: value' line at its first colon and trims the key and value.
+func splitKeyValue(line string) (string, string, bool) {
+	i := strings.Index(line, ":")
+	if i < 0 {
+		return "", "", false
+	}
+	return strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:]), true
+}
@@ -0,0 +1,233 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseJobStatsJobIDs(t *testing.T) {
+	tests := map[string]string{
+		"- job_id: 1234":                      "1234",
+		"- job_id: ABCD":                      "ABCD",
+		"- job_id:  abc .0123 .-_+ AB.1000  ": "abc .0123 .-_+ AB.1000",
+		"- job_id:            kworker/86:1.0": "kworker/86:1.0",
+		"- job_id: (ostnamed.0)":              "(ostnamed.0)",
+		"- job_id:":                           "",
+	}
+
+	for testString, expected := range tests {
+		jobs, err := ParseJobStats(strings.NewReader(testString))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if l := len(jobs); l != 1 {
+			t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 1, l)
+		}
+		if jobs[0].JobID != expected {
+			t.Fatalf("Received an unexpected jobid. Expected: %s, Got: %s", expected, jobs[0].JobID)
+		}
+	}
+
+	testJobBlock := `- job_id:
+  snapshot_time:   1493326943
+  read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
+  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
+  getattr:         { samples:           1, unit:  reqs }
+  setattr:         { samples:           2, unit:  reqs }
+  punch:           { samples:           3, unit:  reqs }
+  sync:            { samples:           4, unit:  reqs }
+  destroy:         { samples:           5, unit:  reqs }
+  create:          { samples:           6, unit:  reqs }
+  statfs:          { samples:           7, unit:  reqs }
+  get_info:        { samples:           8, unit:  reqs }
+  set_info:        { samples:           9, unit:  reqs }
+  quotactl:        { samples:           10, unit:  reqs }
+  - job_id: 28
+  snapshot_time:   1493326943
+  read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
+  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
+  getattr:         { samples:           1, unit:  reqs }
+  setattr:         { samples:           2, unit:  reqs }
+  punch:           { samples:           3, unit:  reqs }
+  sync:            { samples:           4, unit:  reqs }
+  destroy:         { samples:           5, unit:  reqs }
+  create:          { samples:           6, unit:  reqs }
+  statfs:          { samples:           7, unit:  reqs }
+  get_info:        { samples:           8, unit:  reqs }
+  set_info:        { samples:           9, unit:  reqs }
+  quotactl:        { samples:           10, unit:  reqs }`
+
+	jobs, err := ParseJobStats(strings.NewReader(testJobBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(jobs); l != 2 {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 2, l)
+	}
+	if jobs[0].JobID != "" || jobs[1].JobID != "28" {
+		t.Fatalf("Received unexpected jobids. Expected: [ 28], Got: [%s %s]", jobs[0].JobID, jobs[1].JobID)
+	}
+}
+
+func TestParseJobStatsBlockIds(t *testing.T) {
+	testJobBlocks := `- job_id: 67
+	snapshot_time:   1493326943
+	read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
+	write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
+	getattr:         { samples:           1, unit:  reqs }
+	setattr:         { samples:           2, unit:  reqs }
+	punch:           { samples:           3, unit:  reqs }
+	sync:            { samples:           4, unit:  reqs }
+	destroy:         { samples:           5, unit:  reqs }
+	create:          { samples:           6, unit:  reqs }
+	statfs:          { samples:           7, unit:  reqs }
+	get_info:        { samples:           8, unit:  reqs }
+	set_info:        { samples:           9, unit:  reqs }
+	quotactl:        { samples:           10, unit:  reqs }
+	- job_id: 28
+	snapshot_time:   1493326943
+	read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
+	write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
+	getattr:         { samples:           1, unit:  reqs }
+	setattr:         { samples:           2, unit:  reqs }
+	punch:           { samples:           3, unit:  reqs }
+	sync:            { samples:           4, unit:  reqs }
+	destroy:         { samples:           5, unit:  reqs }
+	create:          { samples:           6, unit:  reqs }
+	statfs:          { samples:           7, unit:  reqs }
+	get_info:        { samples:           8, unit:  reqs }
+	set_info:        { samples:           9, unit:  reqs }
+	quotactl:        { samples:           10, unit:  reqs }`
+
+	expectedJobIds := []string{"67", "28"}
+
+	jobs, err := ParseJobStats(strings.NewReader(testJobBlocks))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(jobs); l != len(expectedJobIds) {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", len(expectedJobIds), l)
+	}
+	for index, expected := range expectedJobIds {
+		if jobs[index].JobID != expected {
+			t.Fatalf("Received an unexpected jobId. Expected: %s, Got: %s", expected, jobs[index].JobID)
+		}
+	}
+}
+
+func TestParseJobStats(t *testing.T) {
+	testJobStatsBlock := `job_stats:
+	- job_id:          24
+	  snapshot_time:   1510782606
+	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
+	  write_bytes:     { samples:       64575, unit: bytes, min:    4096, max: 4194304, sum:    215147593728 }
+	  getattr:         { samples:           7, unit:  reqs }
+	  setattr:         { samples:          43, unit:  reqs }
+	  punch:           { samples:           1, unit:  reqs }
+	  sync:            { samples:           8, unit:  reqs }
+	  destroy:         { samples:          12, unit:  reqs }
+	  create:          { samples:           5, unit:  reqs }
+	  statfs:          { samples:           6, unit:  reqs }
+	  get_info:        { samples:          23, unit:  reqs }
+	  set_info:        { samples:          74, unit:  reqs }
+	  quotactl:        { samples:           9, unit:  reqs }
+	- job_id:          26
+	  snapshot_time:   1510782606
+	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
+	  write_bytes:     { samples:       56048, unit: bytes, min:    4096, max: 4194304, sum:    185838792704 }
+	  getattr:         { samples:           7, unit:  reqs }
+	  setattr:         { samples:          43, unit:  reqs }
+	  punch:           { samples:           1, unit:  reqs }
+	  sync:            { samples:           8, unit:  reqs }
+	  destroy:         { samples:          12, unit:  reqs }
+	  create:          { samples:           5, unit:  reqs }
+	  statfs:          { samples:           6, unit:  reqs }
+	  get_info:        { samples:          23, unit:  reqs }
+	  set_info:        { samples:          74, unit:  reqs }
+	  quotactl:        { samples:           9, unit:  reqs }
+	- job_id:          28
+	  snapshot_time:   1510782606
+	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
+	  write_bytes:     { samples:       64208, unit: bytes, min:    4096, max: 4194304, sum:    213963751424 }
+	  getattr:         { samples:           7, unit:  reqs }
+	  setattr:         { samples:          43, unit:  reqs }
+	  punch:           { samples:           1, unit:  reqs }
+	  sync:            { samples:           8, unit:  reqs }
+	  destroy:         { samples:          12, unit:  reqs }
+	  create:          { samples:           5, unit:  reqs }
+	  statfs:          { samples:           6, unit:  reqs }
+	  get_info:        { samples:          23, unit:  reqs }
+	  set_info:        { samples:          74, unit:  reqs }
+	  quotactl:        { samples:           9, unit:  reqs }`
+
+	jobs, err := ParseJobStats(strings.NewReader(testJobStatsBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(jobs); l != 3 {
+		t.Fatalf("Retrieved an unexpected number of jobs. Expected: %d, Got: %d", 3, l)
+	}
+
+	job := jobs[2]
+	if job.JobID != "28" {
+		t.Fatalf("Received an unexpected jobid. Expected: %s, Got: %s", "28", job.JobID)
+	}
+	if expected := time.Unix(1510782606, 0); !job.SnapshotTime.Equal(expected) {
+		t.Fatalf("Retrieved an unexpected snapshot time. Expected: %v, Got: %v", expected, job.SnapshotTime)
+	}
+	if !job.StartTime.IsZero() {
+		t.Fatalf("Retrieved an unexpected start time. Expected: zero, Got: %v", job.StartTime)
+	}
+	if l := len(job.Counters); l != 12 {
+		t.Fatalf("Retrieved an unexpected number of counters. Expected: %d, Got: %d", 12, l)
+	}
+	expected := Counter{Samples: 64208, Unit: "bytes", Min: 4096, Max: 4194304, Sum: 213963751424}
+	if counter := job.Counters["write_bytes"]; counter != expected {
+		t.Fatalf("Retrieved an unexpected counter. Expected: %+v, Got: %+v", expected, counter)
+	}
+	expected = Counter{Samples: 9, Unit: "reqs"}
+	if counter := job.Counters["quotactl"]; counter != expected {
+		t.Fatalf("Retrieved an unexpected counter. Expected: %+v, Got: %+v", expected, counter)
+	}
+}
+
+func TestParseJobStatsHistograms(t *testing.T) {
+	testJobStats := `job_stats:
+- job_id:          29
+  snapshot_time:   1638540802.123456789 secs.nsecs
+  start_time:      1638540000.000000000 secs.nsecs
+  elapsed_time:    802.123456789 secs.nsecs
+  read_bytes:      { samples:           1, unit: bytes, min:    4096, max:    4096, sum:            4096, sumsq:        16777216, hist: { 4K: 1 } }
+  open:            { samples:           2, unit: usecs, min:      12, max:      20, sum:              32, sumsq:             544 }`
+
+	jobs, err := ParseJobStats(strings.NewReader(testJobStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []JobStats{{
+		JobID:        "29",
+		SnapshotTime: time.Unix(1638540802, 123456789),
+		StartTime:    time.Unix(1638540000, 0),
+		Counters: map[string]Counter{
+			"read_bytes": {Samples: 1, Unit: "bytes", Min: 4096, Max: 4096, Sum: 4096, SumSquares: 16777216},
+			"open":       {Samples: 2, Unit: "usecs", Min: 12, Max: 20, Sum: 32, SumSquares: 544},
+		},
+	}}
+	if !reflect.DeepEqual(jobs, expected) {
+		t.Fatalf("Retrieved unexpected jobs. Expected: %+v, Got: %+v", expected, jobs)
+	}
+
+	if _, err := ParseJobStats(strings.NewReader("- job_id: 29\n  open: { samples: many, unit: reqs }")); err == nil {
+		t.Fatal("An error was expected for an invalid counter, but not received")
+	}
+}
@@ -0,0 +1,88 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ClientCount is a number of clients out of all clients, e.g. 'completed_clients: 3/4'.
+type ClientCount struct {
+	Count uint64
+	Total uint64
+}
+
+// RecoveryStatus is a 'recovery_status' file of an OST or MDT.
+// Values not given for the status, e.g. the remaining time of a completed recovery, are zero.
+type RecoveryStatus struct {
+	// Status is the status of the recovery, e.g. 'COMPLETE', 'RECOVERING' or 'INACTIVE'
+	Status           string
+	RecoveryStart    time.Time
+	RecoveryDuration time.Duration
+	TimeRemaining    time.Duration
+	ConnectedClients ClientCount
+	CompletedClients ClientCount
+	EvictedClients   uint64
+	ReplayedRequests uint64
+	QueuedRequests   uint64
+	LastTransno      uint64
+	NextTransno      uint64
+
+	// Values contains all values of the file by their keys, e.g. 'IR'
+	Values map[string]string
+}
+
+// ParseRecoveryStatus parses a 'recovery_status' file.
+func ParseRecoveryStatus(r io.Reader) (RecoveryStatus, error) {
+	values, err := parseValues(r)
+	if err != nil {
+		return RecoveryStatus{}, err
+	}
+	p := valueParser{values: values}
+	status := RecoveryStatus{
+		Status:           p.string("status"),
+		RecoveryStart:    p.time("recovery_start"),
+		RecoveryDuration: p.duration("recovery_duration"),
+		TimeRemaining:    p.duration("time_remaining"),
+		ConnectedClients: p.clientCount("connected_clients"),
+		CompletedClients: p.clientCount("completed_clients"),
+		EvictedClients:   p.uint("evicted_clients"),
+		ReplayedRequests: p.uint("replayed_requests"),
+		QueuedRequests:   p.uint("queued_requests"),
+		LastTransno:      p.uint("last_transno"),
+		NextTransno:      p.uint("next_transno"),
+		Values:           values,
+	}
+	if p.err != nil {
+		return RecoveryStatus{}, p.err
+	}
+	return status, nil
+}
+
+// clientCount parses a number of clients like '3/4'.
+func (p *valueParser) clientCount(key string) ClientCount {
+	value, exists := p.values[key]
+	if !exists {
+		return ClientCount{}
+	}
+	parts := strings.SplitN(value, "/", 2)
+	if len(parts) != 2 {
+		p.setErr(key, fmt.Errorf("invalid number of clients %q", value))
+		return ClientCount{}
+	}
+	count, err := strconv.ParseUint(parts[0], 10, 64)
+	p.setErr(key, err)
+	total, err := strconv.ParseUint(parts[1], 10, 64)
+	p.setErr(key, err)
+	return ClientCount{Count: count, Total: total}
+}
@@ -0,0 +1,74 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseRecoveryStatus(t *testing.T) {
+	testRecoveryStatus := `status: COMPLETE
+recovery_start: 1510605701
+recovery_duration: 0
+completed_clients: 1/1
+replayed_requests: 0
+last_transno: 8589934592
+VBR: DISABLED
+IR: ENABLED`
+
+	status, err := ParseRecoveryStatus(strings.NewReader(testRecoveryStatus))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "COMPLETE" || status.LastTransno != 8589934592 || status.RecoveryDuration != 0 {
+		t.Fatalf("Retrieved an unexpected recovery status: %+v", status)
+	}
+	if expected := time.Unix(1510605701, 0); !status.RecoveryStart.Equal(expected) {
+		t.Fatalf("Retrieved an unexpected recovery start. Expected: %v, Got: %v", expected, status.RecoveryStart)
+	}
+	if expected := (ClientCount{Count: 1, Total: 1}); status.CompletedClients != expected {
+		t.Fatalf("Retrieved unexpected completed clients. Expected: %+v, Got: %+v", expected, status.CompletedClients)
+	}
+	if value := status.Values["IR"]; value != "ENABLED" {
+		t.Fatalf("Retrieved an unexpected value of IR. Expected: %s, Got: %s", "ENABLED", value)
+	}
+
+	testRecoveryStatus = `status: RECOVERING
+recovery_start: 1510605701
+time_remaining: 240
+connected_clients: 3/4
+req_replay_clients: 0
+lock_repay_clients: 0
+completed_clients: 2/4
+evicted_clients: 1
+replayed_requests: 5
+queued_requests: 2
+next_transno: 8589934593`
+
+	status, err = ParseRecoveryStatus(strings.NewReader(testRecoveryStatus))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "RECOVERING" || status.TimeRemaining != 240*time.Second || status.EvictedClients != 1 ||
+		status.ReplayedRequests != 5 || status.QueuedRequests != 2 || status.NextTransno != 8589934593 {
+		t.Fatalf("Retrieved an unexpected recovery status: %+v", status)
+	}
+	if expected := (ClientCount{Count: 3, Total: 4}); status.ConnectedClients != expected {
+		t.Fatalf("Retrieved unexpected connected clients. Expected: %+v, Got: %+v", expected, status.ConnectedClients)
+	}
+	if expected := (ClientCount{Count: 2, Total: 4}); status.CompletedClients != expected {
+		t.Fatalf("Retrieved unexpected completed clients. Expected: %+v, Got: %+v", expected, status.CompletedClients)
+	}
+
+	if _, err := ParseRecoveryStatus(strings.NewReader("completed_clients: 1")); err == nil {
+		t.Fatal("An error was expected for an invalid number of clients, but not received")
+	}
+}
@@ -0,0 +1,120 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+// Package lustre parses the statistics files of Lustre, e.g. 'stats', 'job_stats', 'brw_stats',
+// 'import' and 'recovery_status' below '/proc/fs/lustre' or '/sys/fs/lustre'.
+package lustre
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Counter is a counter of a 'stats' or 'job_stats' file.
+// Min, Max, Sum and SumSquares are only given for the counters with a unit other than 'reqs',
+// e.g. 'read_bytes' or the request times in 'usecs'.
+type Counter struct {
+	Samples    uint64
+	Unit       string
+	Min        uint64
+	Max        uint64
+	Sum        uint64
+	SumSquares uint64
+}
+
+// StatsFile is a 'stats' or 'md_stats' file, e.g. of an OST, MDT or client.
+type StatsFile struct {
+	// SnapshotTime is the time the file was read
+	SnapshotTime time.Time
+	// StartTime is the time the statistics were started, zero if not given by older Lustre versions
+	StartTime time.Time
+	// Counters are the counters by name, e.g. 'read_bytes' or 'open'
+	Counters map[string]Counter
+}
+
+// ParseStatsFile parses a 'stats' or 'md_stats' file. Its lines are in the following format:
+//
+//	snapshot_time             1510950459.787901292 secs.nsecs
+//	read_bytes                3 samples [bytes] 4096 1048576 1052672
+//	ping                      141 samples [reqs]
+func ParseStatsFile(r io.Reader) (StatsFile, error) {
+	stats := StatsFile{Counters: map[string]Counter{}}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		var err error
+		switch {
+		case fields[0] == "snapshot_time":
+			stats.SnapshotTime, err = parseTime(fields[1])
+		case fields[0] == "start_time":
+			stats.StartTime, err = parseTime(fields[1])
+		case len(fields) >= 3 && fields[2] == "samples":
+			// Some counters are listed twice, e.g. 'statfs' of an OST, the first one is kept
+			if _, exists := stats.Counters[fields[0]]; !exists {
+				stats.Counters[fields[0]], err = parseStatsCounter(fields[1:])
+			}
+		}
+		if err != nil {
+			return StatsFile{}, fmt.Errorf("%s: %s", fields[0], err)
+		}
+	}
+	return stats, scanner.Err()
+}
+
+// parseStatsCounter parses the fields of a counter line of a 'stats' file following its name:
+// {samples} 'samples' [{unit}] [{min} {max} {sum} [{sum of squares}]]
+func parseStatsCounter(fields []string) (Counter, error) {
+	var counter Counter
+	var err error
+	counter.Samples, err = strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return Counter{}, err
+	}
+	if len(fields) < 3 {
+		return counter, nil
+	}
+	counter.Unit = strings.Trim(fields[2], "[]")
+	for i, value := range []*uint64{&counter.Min, &counter.Max, &counter.Sum, &counter.SumSquares} {
+		if len(fields) <= 3+i {
+			break
+		}
+		*value, err = strconv.ParseUint(fields[3+i], 10, 64)
+		if err != nil {
+			return Counter{}, err
+		}
+	}
+	return counter, nil
+}
+
+// parseTime parses a time in seconds since the epoch with an optional fraction, e.g. '1510950459.787901292'.
+func parseTime(value string) (time.Time, error) {
+	parts := strings.SplitN(value, ".", 2)
+	seconds, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	var nanoseconds int64
+	if len(parts) == 2 && parts[1] != "" {
+		if len(parts[1]) > 9 {
+			return time.Time{}, fmt.Errorf("invalid time %q", value)
+		}
+		// The fraction is left-aligned, e.g. '.5' are 500000000 nanoseconds
+		nanoseconds, err = strconv.ParseInt(parts[1]+"000000000"[len(parts[1]):], 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return time.Unix(seconds, nanoseconds), nil
+}
@@ -0,0 +1,72 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseStatsFile(t *testing.T) {
+	testStats := `snapshot_time             1510782606.789180921 secs.nsecs
+start_time                1510605701.000000000 secs.nsecs
+elapsed_time              176905.789180921 secs.nsecs
+write_bytes               4298711 samples [bytes] 4096 4194304 16552048697344
+statfs                    35359 samples [reqs]
+connect                   1 samples [reqs]
+statfs                    124430 samples [reqs]
+open                      2 samples [usecs] 12 20 32 544`
+
+	stats, err := ParseStatsFile(strings.NewReader(testStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := StatsFile{
+		SnapshotTime: time.Unix(1510782606, 789180921),
+		StartTime:    time.Unix(1510605701, 0),
+		Counters: map[string]Counter{
+			"write_bytes": {Samples: 4298711, Unit: "bytes", Min: 4096, Max: 4194304, Sum: 16552048697344},
+			"statfs":      {Samples: 35359, Unit: "reqs"},
+			"connect":     {Samples: 1, Unit: "reqs"},
+			"open":        {Samples: 2, Unit: "usecs", Min: 12, Max: 20, Sum: 32, SumSquares: 544},
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Fatalf("Retrieved an unexpected stats file. Expected: %+v, Got: %+v", expected, stats)
+	}
+
+	if _, err := ParseStatsFile(strings.NewReader("ping many samples [reqs]")); err == nil {
+		t.Fatal("An error was expected for an invalid counter, but not received")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	for text, expected := range map[string]time.Time{
+		"1510950459.787901292": time.Unix(1510950459, 787901292),
+		"1638540802":           time.Unix(1638540802, 0),
+		"1638540802.5":         time.Unix(1638540802, 500000000),
+		"4632.123456":          time.Unix(4632, 123456000),
+	} {
+		parsed, err := parseTime(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !parsed.Equal(expected) {
+			t.Fatalf("Retrieved an unexpected time of %q. Expected: %v, Got: %v", text, expected, parsed)
+		}
+	}
+
+	for _, text := range []string{"", "secs.nsecs", "1638540802.1234567890"} {
+		if _, err := parseTime(text); err == nil {
+			t.Fatalf("An error was expected for the time %q, but not received", text)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package lustre
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseValues returns the values of a file in the YAML like format of Lustre by their dotted keys,
+// e.g. 'connection.current_connection'. The nesting is given by the indentation, lists are kept as text.
+func parseValues(r io.Reader) (map[string]string, error) {
+	type parent struct {
+		indent int
+		key    string
+	}
+	values := map[string]string{}
+	var parents []parent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		key, value, ok := splitKeyValue(trimmed)
+		if !ok || key == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+			parents = parents[:len(parents)-1]
+		}
+		if value == "" {
+			parents = append(parents, parent{indent, key})
+			continue
+		}
+		for i := len(parents) - 1; i >= 0; i-- {
+			key = parents[i].key + "." + key
+		}
+		values[key] = value
+	}
+	return values, scanner.Err()
+}
+
+// valueParser converts the values of a file into the fields of a struct, keeping the first error.
+type valueParser struct {
+	values map[string]string
+	err    error
+}
+
+func (p *valueParser) setErr(key string, err error) {
+	if p.err == nil && err != nil {
+		p.err = fmt.Errorf("%s: %s", key, err)
+	}
+}
+
+func (p *valueParser) string(key string) string {
+	return p.values[key]
+}
+
+func (p *valueParser) uint(key string) uint64 {
+	value, exists := p.values[key]
+	if !exists {
+		return 0
+	}
+	n, err := strconv.ParseUint(value, 10, 64)
+	p.setErr(key, err)
+	return n
+}
+
+// list parses a list like '[ write_grant, server_lock ]'.
+func (p *valueParser) list(key string) []string {
+	value, exists := p.values[key]
+	if !exists {
+		return nil
+	}
+	var list []string
+	for _, element := range strings.Split(strings.Trim(value, "[]"), ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			list = append(list, element)
+		}
+	}
+	return list
+}
+
+// time parses a time in seconds since the epoch.
+func (p *valueParser) time(key string) time.Time {
+	value, exists := p.values[key]
+	if !exists {
+		return time.Time{}
+	}
+	t, err := parseTime(value)
+	p.setErr(key, err)
+	return t
+}
+
+// duration parses a duration like '331 usec' or '1 sec', the unit defaults to seconds.
+func (p *valueParser) duration(key string) time.Duration {
+	value, exists := p.values[key]
+	if !exists {
+		return 0
+	}
+	fields := strings.Fields(value)
+	n, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		p.setErr(key, err)
+		return 0
+	}
+	unit := time.Second
+	if len(fields) > 1 {
+		switch fields[1] {
+		case "usec", "usecs":
+			unit = time.Microsecond
+		case "msec", "msecs":
+			unit = time.Millisecond
+		case "sec", "secs":
+		default:
+			p.setErr(key, fmt.Errorf("unknown unit %q", fields[1]))
+		}
+	}
+	return time.Duration(n * float64(unit))
+}
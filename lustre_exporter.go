@@ -14,12 +14,16 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 	"fmt"
-	stdlog "log"
 	"net/http"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/GSI-HPC/lustre_exporter/sources"
@@ -46,33 +50,107 @@ var (
 //LustreSource is a list of all sources that the user would like to collect.
 type LustreSource struct {
 	sourceList map[string]sources.LustreSource
+	// telemetry contains the self-metrics of the sources, nil for none
+	telemetry *sources.Telemetry
 }
 
 //Describe implements the prometheus.Describe interface
 func (l LustreSource) Describe(ch chan<- *prometheus.Desc) {
+	for _, s := range l.sourceList {
+		s.Describe(ch)
+	}
 	scrapeDurations.Describe(ch)
+	l.telemetry.Describe(ch)
 }
 
 //Collect implements the prometheus.Collect interface
 func (l LustreSource) Collect(ch chan<- prometheus.Metric) {
+	l.collectContext(context.Background(), ch)
+}
+
+// collectContext collects the metrics of all sources and the self-metrics, the sources are abandoned once the context is done.
+func (l LustreSource) collectContext(ctx context.Context, ch chan<- prometheus.Metric) {
+	l.collectSelected(ctx, ch, nil)
+	scrapeDurations.Collect(ch)
+	l.telemetry.Collect(ch)
+}
+
+// collectors returns the sorted names of the collectors of all sources.
+func (l LustreSource) collectors() []string {
+	names := map[string]bool{}
+	for _, s := range l.sourceList {
+		for _, name := range s.Collectors() {
+			names[name] = true
+		}
+	}
+	collectors := make([]string, 0, len(names))
+	for name := range names {
+		collectors = append(collectors, name)
+	}
+	sort.Strings(collectors)
+	return collectors
+}
+
+// collectSelected collects the metrics of the selected collectors from all sources.
+func (l LustreSource) collectSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection) {
+	// Invalid metrics and duplicate series are dropped before they fail the whole gather
+	metrics := make(chan prometheus.Metric)
+	filtered := make(chan struct{})
+	go func() {
+		l.telemetry.FilterMetrics(metrics, ch)
+		close(filtered)
+	}()
+
 	wg := sync.WaitGroup{}
 	wg.Add(len(l.sourceList))
 	for name, c := range l.sourceList {
 		go func(name string, c sources.LustreSource) {
-			collectFromSource(name, c, ch)
+			collectFromSource(ctx, name, c, metrics, selection)
 			wg.Done()
 		}(name, c)
 	}
 	wg.Wait()
-	scrapeDurations.Collect(ch)
+	close(metrics)
+	<-filtered
 }
 
-func collectFromSource(name string, s sources.LustreSource, ch chan<- prometheus.Metric) {
+// collectFromSource forwards the metrics of the source until it is done or the context is done.
+// A source blocked on a file read cannot be interrupted, so it is abandoned and its remaining metrics are discarded.
+func collectFromSource(ctx context.Context, name string, s sources.LustreSource, ch chan<- prometheus.Metric, selection sources.Selection) {
 	result := "success"
 	begin := time.Now()
-	err := s.Update(ch)
+	metrics := make(chan prometheus.Metric)
+	updated := make(chan error, 1)
+	go func() {
+		err := s.UpdateSelected(ctx, metrics, selection)
+		close(metrics)
+		updated <- err
+	}()
+
+	var err error
+forward:
+	for {
+		select {
+		case metric, ok := <-metrics:
+			if !ok {
+				err = <-updated
+				break forward
+			}
+			ch <- metric
+		case <-ctx.Done():
+			go func() {
+				for range metrics {
+				}
+			}()
+			err = ctx.Err()
+			break forward
+		}
+	}
 	duration := time.Since(begin)
-	if err != nil {
+	if err != nil && ctx.Err() != nil {
+		log.Errorf("source %q timed out after %f seconds - %s", name, duration.Seconds(), err)
+		result = "timeout"
+	} else if err != nil {
 		log.Errorf("source %q failed after %f seconds - %s", name, duration.Seconds(), err)
 		result = "error"
 	} else {
@@ -81,13 +159,13 @@ func collectFromSource(name string, s sources.LustreSource, ch chan<- prometheus
 	scrapeDurations.WithLabelValues(name, result).Observe(duration.Seconds())
 }
 
-func loadSources(list []string) (map[string]sources.LustreSource, []error) {
+func loadSources(list []string, config sources.Config) (map[string]sources.LustreSource, []error) {
 	sourceList := map[string]sources.LustreSource{}
 	var errList []error
 	for _, name := range list {
 		fn, ok := sources.Factories[name]
 		if ok {
-			if c := fn(); c != nil {
+			if c := fn(config); c != nil {
 				sourceList[name] = c
 				continue
 			}
@@ -97,6 +175,19 @@ func loadSources(list []string) (map[string]sources.LustreSource, []error) {
 	return sourceList, errList
 }
 
+func logCollectorStates(config sources.Config) {
+	log.Infof("Collector status:")
+	log.Infof(" - OST State: %s", config.Ost)
+	log.Infof(" - MDT State: %s", config.Mdt)
+	log.Infof(" - MGS State: %s", config.Mgs)
+	log.Infof(" - MDS State: %s", config.Mds)
+	log.Infof(" - Client State: %s", config.Client)
+	log.Infof(" - Client Per-Process State: %t", config.ClientPerProcess)
+	log.Infof(" - Generic State: %s", config.Generic)
+	log.Infof(" - LNET State: %s", config.Lnet)
+	log.Infof(" - Health State: %s", config.Health)
+}
+
 func initLogFile(path string) {
 	logFile, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
 	if err != nil {
@@ -118,14 +209,46 @@ func main() {
 		mgsEnabled          = kingpin.Flag("collector.mgs", "Set MGS metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		ostEnabled          = kingpin.Flag("collector.ost", "Set OST metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		healthStatusEnabled = kingpin.Flag("collector.health", "Set Health metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
+		autoEnabled         = kingpin.Flag("collector.auto", "Enable the OST, MDT, MGS, MDS and client collectors based on the Lustre devices present, using their configured levels.").Default("false").Bool()
+		background          = kingpin.Flag("collector.background", "Collect the sources in the background and serve the metrics of the latest snapshot on scrape.").Default("false").Bool()
+		backgroundInterval  = kingpin.Flag("collector.background.interval", "Interval of the background collection.").Default("15s").Duration()
+		sourceIntervals     = kingpin.Flag("collector.background.source-interval", "Interval of the background collection of a single source, e.g. 'procfs=1m', can be repeated.").StringMap()
+		autoInterval        = kingpin.Flag("collector.auto.interval", "Interval to detect the node roles again in automatic mode.").Default("1m").Duration()
+		clientPerProcess    = kingpin.Flag("collector.client.per-process", "Enable per-process client extent statistics labelled by pid and command.").Default("false").Bool()
+		snapshotTimestamps  = kingpin.Flag("collector.snapshot-timestamps", "Export the samples of stats, job_stats, brw_stats and rpc_stats files with their snapshot_time as timestamp.").Default("false").Bool()
+		configFile          = kingpin.Flag("config.file", "YAML file selecting metrics and setting collector options, overriding the collector flags.").Default("").String()
+		fsnameInclude       = kingpin.Flag("filter.fsname.include", "Regular expression of the filesystem names to collect, all others are skipped.").Default("").String()
+		fsnameExclude       = kingpin.Flag("filter.fsname.exclude", "Regular expression of the filesystem names to skip.").Default("").String()
+		targetInclude       = kingpin.Flag("filter.target.include", "Regular expression of the target names to collect, e.g. 'lustrefs-OST00[0-3].', all others are skipped.").Default("").String()
+		targetExclude       = kingpin.Flag("filter.target.exclude", "Regular expression of the target names to skip.").Default("").String()
+		replayArchive       = kingpin.Flag("replay", "Serve the metrics of a .tar.gz archive of the proc, sys and lctl trees of a node instead of the local node.").Default("").String()
+		pushURL             = kingpin.Flag("push.url", "URL of a Pushgateway to push the metrics of the enabled collectors to in the push interval instead of serving them.").Default("").String()
+		pushJob             = kingpin.Flag("push.job", "Job label of the pushed metrics.").Default("lustre_exporter").String()
+		pushInterval        = kingpin.Flag("push.interval", "Interval of the pushes.").Default("1m").Duration()
+		pushGrouping        = kingpin.Flag("push.grouping", "Grouping label of the pushed metrics, e.g. 'fsname=lustrefs' or 'role=client', can be repeated. The instance label defaults to the hostname.").StringMap()
+		pushRetries         = kingpin.Flag("push.retries", "Number of retries of a failed push.").Default("3").Int()
+		pushBackoff         = kingpin.Flag("push.retry-backoff", "Wait before the first retry of a failed push, doubled for each further retry up to the push interval.").Default("1s").Duration()
+		pushOnShutdown      = kingpin.Flag("push.on-shutdown", "Push the metrics a last time or delete them from the Pushgateway on SIGINT and SIGTERM. Valid values: [push, delete]").Default("delete").Enum(pushOnShutdownPush, pushOnShutdownDelete)
+		textfileOutput      = kingpin.Flag("output.textfile", "Collect the enabled sources once, write the metrics in the text format to the file, e.g. into the textfile directory of the node_exporter, and exit.").Default("").String()
+		procPath            = kingpin.Flag("path.procfs", "procfs mountpoint.").Default("/proc").String()
+		sysPath             = kingpin.Flag("path.sysfs", "sysfs mountpoint.").Default("/sys").String()
+		debugPath           = kingpin.Flag("path.debugfs", "debugfs mountpoint, 'kernel/debug' within --path.sysfs if empty.").Default("").String()
+		mountInfo           = kingpin.Flag("path.mountinfo", "Mount table used to resolve client instances to filesystem names and mount points, 'self/mountinfo' within --path.procfs if empty.").Default("").String()
 		listenAddress       = kingpin.Flag("web.listen-address", "Address to use to expose Lustre metrics.").Default(":9169").String()
+		scrapeTimeout       = kingpin.Flag("scrape.timeout", "Deadline of each source during a scrape, lowered by the timeout sent by Prometheus, 0 for none. Also limits each background collection.").Default("1m").Duration()
 		metricsPath         = kingpin.Flag("web.telemetry-path", "Path to use to expose Lustre metrics.").Default("/metrics").String()
 		logLevel            = kingpin.Flag("log.level", "Set log level. Valid levels: [debug, info, warn, error]").Default("info").Enum("debug", "info", "warn", "error")
 		logFile             = kingpin.Flag("log.file", "Redirect log output to specified file.").Default("").String()
 		printVersion        = kingpin.Flag("version", "Print version.").Short('v').Bool()
+
+		captureCommand      = kingpin.Command("capture", "Capture the files and lctl output read by the enabled collectors into an archive for --replay.")
+		captureOutput       = captureCommand.Flag("output", "Archive to write, e.g. 'node.tar.gz'.").Short('o').Required().String()
+		captureRedactNIDs   = captureCommand.Flag("redact.nids", "Replace the NIDs by pseudonyms.").Default("false").Bool()
+		captureRedactJobids = captureCommand.Flag("redact.jobids", "Replace the jobids by pseudonyms.").Default("false").Bool()
 	)
+	kingpin.Command("serve", "Serve the metrics of the enabled collectors.").Default()
 
-	kingpin.Parse()
+	command := kingpin.Parse()
 
 	if *printVersion {
 		fmt.Print(exporterVersion)
@@ -145,27 +268,117 @@ func main() {
 
 	log.Info("Starting...")
 
-	log.Infof("Collector status:")
-	sources.OstEnabled = *ostEnabled
-	log.Infof(" - OST State: %s", sources.OstEnabled)
-	sources.MdtEnabled = *mdtEnabled
-	log.Infof(" - MDT State: %s", sources.MdtEnabled)
-	sources.MgsEnabled = *mgsEnabled
-	log.Infof(" - MGS State: %s", sources.MgsEnabled)
-	sources.MdsEnabled = *mdsEnabled
-	log.Infof(" - MDS State: %s", sources.MdsEnabled)
-	sources.ClientEnabled = *clientEnabled
-	log.Infof(" - Client State: %s", sources.ClientEnabled)
-	sources.GenericEnabled = *genericEnabled
-	log.Infof(" - Generic State: %s", sources.GenericEnabled)
-	sources.LnetEnabled = *lnetEnabled
-	log.Infof(" - LNET State: %s", sources.LnetEnabled)
-	sources.HealthStatusEnabled = *healthStatusEnabled
-	log.Infof(" - Health State: %s", sources.HealthStatusEnabled)
+	config := sources.DefaultConfig()
+	config.Ost = *ostEnabled
+	config.Mdt = *mdtEnabled
+	config.Mgs = *mgsEnabled
+	config.Mds = *mdsEnabled
+	config.Client = *clientEnabled
+	config.ClientPerProcess = *clientPerProcess
+	config.SnapshotTimestamps = *snapshotTimestamps
+	config.Generic = *genericEnabled
+	config.Lnet = *lnetEnabled
+	config.Health = *healthStatusEnabled
+
+	targetFilter, err := sources.NewTargetFilter(*fsnameInclude, *fsnameExclude, *targetInclude, *targetExclude)
+	if err != nil {
+		log.Fatal(err)
+	}
+	config.TargetFilter = targetFilter
+
+	config.Paths.Proc, config.Paths.Sys, config.Paths.Debug, config.Paths.MountInfo = *procPath, *sysPath, *debugPath, *mountInfo
+	if config.Paths.Debug == "" {
+		config.Paths.Debug = filepath.Join(config.Paths.Sys, "kernel/debug")
+	}
+
+	if *replayArchive != "" {
+		dir, err := startReplay(*replayArchive)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer removeReplayDir(dir)
+		removeReplayDirOnFatal(dir)
+		// In push mode the directory is removed after the last push on a signal
+		if *pushURL == "" {
+			removeReplayDirOnSignal(dir)
+		}
+		// The replayed lctl output is read from the archive instead of running lctl
+		config.Paths = replayPaths(dir)
+		config.CommandRunner = nil
+		log.Infof("Replaying archive %s extracted to %s", *replayArchive, dir)
+	}
+
+	if *configFile != "" {
+		fileConfig, err := loadConfig(*configFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileConfig.apply(&config)
+		log.Infof("Loaded configuration file %s with %d metric rules", *configFile, len(config.MetricRules))
+	}
+
+	if (*textfileOutput != "" || *pushURL != "") && config.SnapshotTimestamps {
+		// The textfile collector of the node_exporter and the Pushgateway reject samples with timestamps
+		log.Warn("Snapshot timestamps are disabled in textfile and push mode")
+		config.SnapshotTimestamps = false
+	}
+
+	if version, err := sources.DetectLustreVersion(config.Paths); err != nil {
+		log.Warnf("Unable to detect Lustre version: %s", err)
+	} else {
+		log.Infof("Lustre version: %s", version)
+	}
 
 	enabledSources := []string{"procfs", "sys", "sysfs", "lctl"}
 
-	sourceList, errList := loadSources(enabledSources)
+	if command == captureCommand.FullCommand() {
+		sourceList, errList := loadSources(enabledSources, config)
+		if errList != nil {
+			for _, err := range errList {
+				log.Errorf("Couldn't load source: %s", err)
+			}
+			log.Fatal("Unable to load sources")
+		}
+		redactor := newCaptureRedactor(*captureRedactNIDs, *captureRedactJobids)
+		if err := runCapture(context.Background(), config.Paths, sourceList, *captureOutput, redactor); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	var intervals *backgroundIntervals
+	// The textfile and push modes collect the sources themselves
+	if *background && *textfileOutput == "" && *pushURL == "" {
+		intervals, err = newBackgroundIntervals(*backgroundInterval, *sourceIntervals)
+		if err != nil {
+			log.Fatal(err)
+		}
+		intervals.timeout = *scrapeTimeout
+	}
+
+	var collector selectableCollector
+	var sourceList map[string]sources.LustreSource
+	var errList []error
+	loadedConfig := config
+	if *autoEnabled {
+		var a *autoSource
+		a, errList = newAutoSource(enabledSources, config, intervals)
+		if a != nil {
+			log.Infof("Detected node roles: %v", a.roles)
+			loadedConfig = applyNodeRoles(a.roles, config)
+			sourceList = a.source.sourceList
+			collector = a
+			if *textfileOutput == "" {
+				go a.run(*autoInterval)
+			}
+		}
+	} else {
+		sourceList, errList = loadSources(enabledSources, config)
+		if intervals != nil && errList == nil {
+			sourceList = startBackgroundCollection(sourceList, intervals)
+		}
+		collector = LustreSource{sourceList: sourceList, telemetry: config.Telemetry}
+	}
 
 	if errList != nil {
 		for _, err := range errList {
@@ -174,18 +387,61 @@ func main() {
 		log.Fatal("Unable to load sources")
 	}
 
+	logCollectorStates(loadedConfig)
+
 	log.Infof("Available sources:")
 
 	for s := range sourceList {
-		log.Infof(" - %s", s)
+		if intervals != nil {
+			log.Infof(" - %s (background interval %s)", s, intervals.interval(s))
+		} else {
+			log.Infof(" - %s", s)
+		}
+	}
+
+	if *textfileOutput != "" {
+		if err := writeTextfile(collector, *textfileOutput, *scrapeTimeout); err != nil {
+			log.Fatalf("Unable to write %s: %s", *textfileOutput, err)
+		}
+		log.Infof("Wrote metrics to %s", *textfileOutput)
+		return
 	}
 
-	prometheus.MustRegister(LustreSource{sourceList: sourceList})
+	if *pushURL != "" {
+		if *pushInterval <= 0 {
+			log.Fatalf("Invalid push interval %s", *pushInterval)
+		}
+		grouping, err := newPushGrouping(*pushGrouping)
+		if err != nil {
+			log.Fatal(err)
+		}
+		pusher := &metricsPusher{
+			collector:  collector,
+			url:        *pushURL,
+			job:        *pushJob,
+			grouping:   grouping,
+			interval:   *pushInterval,
+			timeout:    *scrapeTimeout,
+			retries:    *pushRetries,
+			backoff:    *pushBackoff,
+			onShutdown: *pushOnShutdown,
+		}
+		ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
+		log.Infof("Pushing metrics to %s every %s with grouping labels %v", *pushURL, *pushInterval, grouping)
+		if err := pusher.run(ctx); err != nil {
+			log.Errorf("Unable to %s metrics on shutdown: %s", *pushOnShutdown, err)
+		}
+		return
+	}
+
+	// The sources are registered for each scrape with its deadline, registering them once verifies their descriptors
+	if err := prometheus.NewRegistry().Register(collector); err != nil {
+		log.Fatalf("Unable to register sources: %s", err)
+	}
 	//load InstrumentMetricHandler
 	handler := promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
-		promhttp.HandlerFor(prometheus.DefaultGatherer,
-			promhttp.HandlerOpts{
-				ErrorLog: stdlog.New(os.Stderr, "", stdlog.LstdFlags)}))
+		newMetricsHandler(collector, prometheus.DefaultGatherer, *scrapeTimeout))
 
 	http.Handle(*metricsPath, handler)
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
@@ -203,7 +459,7 @@ func main() {
 	})
 
 	log.Info("Listening on", *listenAddress)
-	err := http.ListenAndServe(*listenAddress, nil)
+	err = http.ListenAndServe(*listenAddress, nil)
 	if err != nil {
 		log.Fatal("Error on Listen", err)
 	}
@@ -54,81 +54,41 @@ var (
 	errMetricAlreadyParsed = errors.New("metric already parsed")
 )
 
-func toggleCollectors(target string) {
+// fixturePaths are the paths of the local test fixtures
+var fixturePaths = sources.Paths{Proc: "proc", Sys: "sys", Debug: "sys/kernel/debug", Lctl: "lctl"}
+
+// fixtureConfig returns the config of the sources reading the local test fixtures with all collectors enabled.
+func fixtureConfig() sources.Config {
+	config := sources.DefaultConfig()
+	config.Paths = fixturePaths
+	config.CommandRunner = nil
+	return config
+}
+
+// targetConfig returns the fixture config with only the collector of the target enabled.
+func targetConfig(target string) sources.Config {
+	config := fixtureConfig()
+	config.Ost, config.Mdt, config.Mgs, config.Mds = "disabled", "disabled", "disabled", "disabled"
+	config.Client, config.Generic, config.Lnet, config.Health = "disabled", "disabled", "disabled", "disabled"
 	switch target {
 	case "OST":
-		sources.OstEnabled = "extended"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Ost = "extended"
 	case "MDT":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "extended"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Mdt = "extended"
 	case "MGS":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "extended"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Mgs = "extended"
 	case "MDS":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "extended"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Mds = "extended"
 	case "Client":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "extended"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Client = "extended"
 	case "Generic":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "extended"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "disabled"
+		config.Generic = "extended"
 	case "LNET":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "extended"
-		sources.HealthStatusEnabled = "disabled"
+		config.Lnet = "extended"
 	case "Health":
-		sources.OstEnabled = "disabled"
-		sources.MdtEnabled = "disabled"
-		sources.MgsEnabled = "disabled"
-		sources.MdsEnabled = "disabled"
-		sources.ClientEnabled = "disabled"
-		sources.GenericEnabled = "disabled"
-		sources.LnetEnabled = "disabled"
-		sources.HealthStatusEnabled = "extended"
+		config.Health = "extended"
 	}
+	return config
 }
 
 func stringAlphabetize(str1 string, str2 string) (int, error) {
@@ -139,7 +99,7 @@ func stringAlphabetize(str1 string, str2 string) (int, error) {
 		letterCount = len(str1)
 	}
 
-	for i := 0; i < letterCount-1; i++ {
+	for i := 0; i < letterCount; i++ {
 		if str1[i] == str2[i] {
 			continue
 		} else if str1[i] > str2[i] {
@@ -149,6 +109,13 @@ func stringAlphabetize(str1 string, str2 string) (int, error) {
 		}
 	}
 
+	// A label that is a prefix of another label comes first
+	if len(str1) > len(str2) {
+		return 1, nil
+	} else if len(str1) < len(str2) {
+		return 2, nil
+	}
+
 	return 0, fmt.Errorf("Duplicate label detected: %q", str1)
 }
 
@@ -204,1284 +171,1421 @@ func blacklisted(blacklist []string, metricName string) bool {
 
 func TestCollector(t *testing.T) {
 	targets := []string{"OST", "MDT", "MGS", "MDS", "Client", "Generic", "LNET", "Health"}
-	// Override the default file location to the local proc directory
-	sources.ProcLocation = "proc"
-	sources.SysLocation = "sys"
-
-	sources.LctlCommandMode = false
 
 	expectedMetrics := []promType{
 		// OST Metrics
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 2, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0002"}}, 2, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0004"}}, 2, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0006"}}, 2, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}}, 141, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}}, 645, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}}, 645, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}}, 645, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 35359, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0002"}}, 35354, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0004"}}, 35350, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0006"}}, 35347, false},
-		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 150, false},
-		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 150, false},
-		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 150, false},
-		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 150, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 46956, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 64575, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 71996, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 56048, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 60729, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 64208, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 57773, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 30804, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 28787, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 32411, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 29079, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 27390, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 29417, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 29390, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 28191, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 25852, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 26061, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 55053, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 27161, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 27210, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 7906, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 8254, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 7810, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 8579, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 8051, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 8176, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 7404, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 8246, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 7887, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 7969, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 7632, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 7786, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 7609, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 7656, false},
-		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 7722, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.5927444e+07, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1.474012704e+09, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 9.82675104e+08, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 9.82675104e+08, false},
-		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 900, false},
-		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 900, false},
-		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 900, false},
-		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 3, false},
-		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 3, false},
-		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 3, false},
-		{"lustre_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.298711e+06, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}}, 1048576, false},
-		{"lustre_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.6552048697344e+13, false},
-		{"lustre_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.7025124352e+10, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4.7168396288e+10, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 3.1445593088e+10, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 3.1445593088e+10, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 4.09674e+06, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 3, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 174382, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 20244, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 4037, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 1577, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 925, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 579, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 190, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 35, false},
-		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 600, false},
-		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 600, false},
-		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 600, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0002"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0004"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0006"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 4.059303e+06, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 13817, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 1367, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 58945, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 2911, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 358, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 154861, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 6161, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 679, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1.048576e+06, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1.048576e+06, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1.048576e+06, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 1.55500642304e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 2.15147593728e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 2.40823762944e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 1.85838792704e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 2.01320374272e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 2.13963751424e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 1.90641672192e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 1.01291593728e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 9.6291004416e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 1.08391518208e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 9.6846708736e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 9.095131136e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 9.837008896e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 9.6616685568e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 9.4293942272e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 8.5505073152e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 8.6011281408e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 1.82426591232e+11, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 8.9955606528e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 9.0020810752e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 2.1711745024e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 2.370156544e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 2.1836828672e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 2.4320741376e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 2.3049785344e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 2.331467776e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 1.9928662016e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 2.3387901952e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 2.196652032e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 2.1969199104e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 2.0787339264e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 2.1327118336e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 2.080346112e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 2.1190914048e+10, false},
-		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 2.0557369344e+10, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.7168367616e+10, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4.71684096e+10, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 3.14456064e+10, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 3.14456064e+10, false},
-		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 5.94706432e+09, false},
-		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 5.0528256e+07, false},
-		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 5.0528256e+07, false},
-		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 5.0528256e+07, false},
-		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0002"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0004"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0006"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}}, 58945, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}}, 2911, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}}, 358, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}}, 154861, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}}, 6161, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}}, 679, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}}, 4.059303e+06, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}}, 13817, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}}, 1367, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}}, 4096, false},
-		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4, false},
-		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4, false},
-		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 4, false},
-		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 4, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.5927188e+07, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1.474012448e+09, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 9.82674848e+08, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 9.82674848e+08, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 125, false},
-		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}}, 3153, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 5, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}}, 74, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 176, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000"}}, 164, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000"}}, 187, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000"}}, 185, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 186, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "17"}, {"target", "lustrefs-OST0000"}}, 181, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "18"}, {"target", "lustrefs-OST0000"}}, 170, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "19"}, {"target", "lustrefs-OST0000"}}, 164, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 158, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "20"}, {"target", "lustrefs-OST0000"}}, 187, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "21"}, {"target", "lustrefs-OST0000"}}, 174, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "22"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "23"}, {"target", "lustrefs-OST0000"}}, 178, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "24"}, {"target", "lustrefs-OST0000"}}, 179, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "25"}, {"target", "lustrefs-OST0000"}}, 205, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "26"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "27"}, {"target", "lustrefs-OST0000"}}, 160, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "28"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "29"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 200, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "30"}, {"target", "lustrefs-OST0000"}}, 195, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "31"}, {"target", "lustrefs-OST0000"}}, 4.293275e+06, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 156, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 175, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 163, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 185, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 159, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 160, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 5.3215232e+07, false},
-		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 5.815533568e+09, false},
-		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.7029440512e+10, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4.7168398336e+10, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 3.1445595136e+10, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 3.1445595136e+10, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 512000, false},
-		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}}, 2081591296, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
-		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 600, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "17"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "18"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "19"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "20"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "21"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "22"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "23"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "24"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "25"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "26"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "27"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "28"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "29"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "30"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "31"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 3, false},
-		{"lustre_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
-		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 900, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.048576e+06, false},
-		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.0828928e+07, false},
-		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4.0828928e+07, false},
-		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 4.0828928e+07, false},
-		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 4.0828928e+07, false},
-		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 128, false},
-		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 128, false},
-		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 128, false},
-		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 128, false},
-		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 16, false},
-		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 16, false},
-		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 16, false},
-		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 16, false},
-		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 32, false},
-		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 32, false},
-		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 32, false},
-		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 32, false},
-		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 2, false},
-		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 2, false},
-		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 2, false},
-		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 2, false},
-		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1200, false},
-		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1200, false},
-		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1200, false},
-		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1200, false},
-		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 32207, false},
-		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 128827, false},
-		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 32207, false},
-		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 128827, false},
-		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 31, false},
-		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 31, false},
-		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 31, false},
-		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 31, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "connect"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 2, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 2, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 2, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 141, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 645, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 645, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 645, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 35359, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 35354, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 35350, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 35347, false},
+		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 150, false},
+		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 150, false},
+		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 150, false},
+		{"lustre_recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 150, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 46956, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 64575, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 71996, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 56048, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 60729, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 64208, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 57773, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 30804, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 28787, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 32411, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 29079, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 27390, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 29417, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 29390, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 28191, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 25852, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 26061, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 55053, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 27161, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 27210, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7906, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8254, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7810, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8579, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8051, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8176, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7404, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8246, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7887, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7969, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7632, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7786, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7609, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7656, false},
+		{"lustre_job_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7722, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.5927444e+07, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1.474012704e+09, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 9.82675104e+08, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 9.82675104e+08, false},
+		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 900, false},
+		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 900, false},
+		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 900, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 3, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 3, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 3, false},
+		{"lustre_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.298711e+06, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1048576, false},
+		{"lustre_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.6552048697344e+13, false},
+		{"lustre_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.7025124352e+10, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4.7168396288e+10, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 3.1445593088e+10, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 3.1445593088e+10, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 23, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 23, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 23, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.09674e+06, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 3, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 174382, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 20244, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4037, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1577, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 925, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 579, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 190, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 35, false},
+		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 600, false},
+		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 600, false},
+		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 600, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 13, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 13, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 13, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 13, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 10, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 10, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 10, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 10, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 153, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.059303e+06, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 13817, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1367, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 157, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 58945, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2911, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 358, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 154861, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6161, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 679, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1.048576e+06, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1.048576e+06, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1.048576e+06, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.55500642304e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.15147593728e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.40823762944e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.85838792704e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.01320374272e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.13963751424e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.90641672192e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.01291593728e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.6291004416e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.08391518208e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.6846708736e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.095131136e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.837008896e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.6616685568e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.4293942272e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8.5505073152e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8.6011281408e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.82426591232e+11, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8.9955606528e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9.0020810752e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.1711745024e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.370156544e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.1836828672e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.4320741376e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.3049785344e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.331467776e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.9928662016e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.3387901952e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.196652032e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.1969199104e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.0787339264e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.1327118336e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.080346112e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.1190914048e+10, false},
+		{"lustre_job_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2.0557369344e+10, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.7168367616e+10, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4.71684096e+10, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 3.14456064e+10, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 3.14456064e+10, false},
+		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5.94706432e+09, false},
+		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 5.0528256e+07, false},
+		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 5.0528256e+07, false},
+		{"lustre_exports_granted_total", "Total number of exports that have been marked granted", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 5.0528256e+07, false},
+		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 2, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 2, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 2, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 4, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 4, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 12, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 12, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 12, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 58945, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2911, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 358, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 154861, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6161, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 679, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 153, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.059303e+06, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 13817, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1367, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 157, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4096, false},
+		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4, false},
+		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4, false},
+		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 4, false},
+		{"lustre_brw_size_megabytes", "Block read/write size in megabytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 4, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.5927188e+07, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1.474012448e+09, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 9.82674848e+08, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 9.82674848e+08, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 125, false},
+		{"lustre_job_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 3153, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "destroy"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "get_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "getattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "punch"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "quotactl"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "set_info"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 74, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 43, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 6, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 8, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 23, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 23, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 23, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 23, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 153, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 157, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 176, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 164, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 187, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 185, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 168, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 168, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 186, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "17"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 181, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "18"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 170, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "19"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 164, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 158, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "20"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 187, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "21"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 174, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "22"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 168, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 178, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 179, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 205, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 192, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 160, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 192, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 192, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 200, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 195, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.293275e+06, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 156, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 175, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 163, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 185, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 159, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 160, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5.3215232e+07, false},
+		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 5.815533568e+09, false},
+		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_exports_pending_total", "Total number of exports that have been marked pending", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.7029440512e+10, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4.7168398336e+10, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 3.1445595136e+10, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 3.1445595136e+10, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 512000, false},
+		{"lustre_job_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "ost"}, {"jobid", "loop36"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 2081591296, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "33"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "34"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "35"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "36"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "37"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "38"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "39"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "40"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "41"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "42"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "43"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "44"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "45"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "46"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "47"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "48"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "49"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "50"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "51"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "52"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "53"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "54"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "55"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.194304e+06, false},
+		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 600, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "17"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "18"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "19"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "20"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "21"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "22"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "23"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "24"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "25"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "26"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "27"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "28"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "29"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "30"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "31"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 3, false},
+		{"lustre_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4096, false},
+		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 900, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1.048576e+06, false},
+		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 4.0828928e+07, false},
+		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 4.0828928e+07, false},
+		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 4.0828928e+07, false},
+		{"lustre_grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 4.0828928e+07, false},
+		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 128, false},
+		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 128, false},
+		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 128, false},
+		{"lustre_precreate_batch", "Maximum number of objects that can be included in a single transaction", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 128, false},
+		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 16, false},
+		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 16, false},
+		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 16, false},
+		{"lustre_soft_sync_limit", "Number of RPCs necessary before triggering a sync", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 16, false},
+		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1, false},
+		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_lock_count", "Number of locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 0, false},
+		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 0, false},
+		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 0, false},
+		{"lustre_lock_timeout", "Number of lock timeouts", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 0, false},
+		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 32, false},
+		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 32, false},
+		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 32, false},
+		{"lustre_lock_contended", "Number of contended locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 32, false},
+		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 2, false},
+		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 2, false},
+		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 2, false},
+		{"lustre_lock_contention_seconds", "Time in seconds during which locks were contended", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 2, false},
+		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 1200, false},
+		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 1200, false},
+		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 1200, false},
+		{"lustre_lock_granted", "Number of granted locks", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 1200, false},
+		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 32207, false},
+		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 128827, false},
+		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 32207, false},
+		{"lustre_lock_grant_plan", "Number of planned lock grants per second", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 128827, false},
+		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}}, 31, false},
+		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}}, 31, false},
+		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}}, 31, false},
+		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}}, 31, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "23"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "24"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "25"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "26"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "27"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "28"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "29"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "30"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "31"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "32"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "33"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "34"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "35"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "36"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "37"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "38"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "39"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "40"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "41"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "42"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "43"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "44"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "45"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "46"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "47"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "48"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "49"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "50"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "51"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "52"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "53"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "54"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "55"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "56"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"jobid", "57"}}, 1510782606.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"jobid", "loop36"}}, 1638540802.0, false},
 
 		// MDT Metrics
-		{"lustre_changelog_current_index", "Changelog current index.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 34, false},
-		{"lustre_changelog_user_index", "Index of registered changelog user.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"id", "cl1"}}, 0, false},
-		{"lustre_changelog_user_index", "Index of registered changelog user.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"id", "cl2"}}, 34, false},
-		{"lustre_changelog_user_idle_time", "Idle time in seconds of registered changelog user.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"id", "cl1"}}, 1725676, false},
-		{"lustre_changelog_user_idle_time", "Idle time in seconds of registered changelog user.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"id", "cl2"}}, 28, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 87, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 45, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 11, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}}, 13, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 4, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 93, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}}, 48, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}}, 7, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}}, 22, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 82, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}}, 12, false},
-		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}}, 37, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 9, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 16, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 10, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 57, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 1, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 2, false},
-		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 10, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 131072, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.24150656e+09, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4.30405522e+08, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241498368e+09, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4.30405292e+08, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241500416e+09, false},
+		{"lustre_changelog_current_index", "Changelog current index.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 34, false},
+		{"lustre_changelog_user_index", "Index of registered changelog user.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"id", "cl1"}}, 0, false},
+		{"lustre_changelog_user_index", "Index of registered changelog user.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"id", "cl2"}}, 34, false},
+		{"lustre_changelog_user_idle_time", "Idle time in seconds of registered changelog user.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"id", "cl1"}}, 1725676, false},
+		{"lustre_changelog_user_idle_time", "Idle time in seconds of registered changelog user.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"id", "cl2"}}, 28, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "43"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "44"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "45"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "46"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "47"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "48"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "49"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "50"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "51"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "52"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "53"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "54"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "55"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "56"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 87, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "crossdir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 45, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 11, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "link"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "mkdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 13, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 93, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 48, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "rmdir"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 7, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "samedir_rename"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "setxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 22, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 82, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "sync"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 12, false},
+		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"jobid", "57"}, {"operation", "unlink"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 37, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 9, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 16, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 10, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 57, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 10, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 131072, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2.24150656e+09, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4.30405522e+08, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2.241498368e+09, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 4.30405292e+08, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 2.241500416e+09, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the OSP device is active for object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Current number of object destroys in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 20000, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status_code", "Object precreation status of the OSP device as error code, 0 refers to 'ok'", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, -28, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ok"}}, 1, false},
+		{"lustre_osp_prealloc_status", "Object precreation status of the OSP device, the current status is labelled with value 1", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}, {"status", "ENOSPC"}}, 1, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 67, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object id precreated on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Current number of changes pending to be synced to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0007-osc-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "7"}, {"peer_target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_async_commit_total", "Total number of asynchronous commits", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 0, false},
+		{"lustre_commit_on_sharing_enabled", "Returns '1' if commit on sharing is enabled", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 0, false},
+		{"lustre_sync_total", "Total number of synchronous commits", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}}, 0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "43"}}, 1510781837.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "44"}}, 1510781837.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "45"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "46"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "47"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "48"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "49"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "50"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "51"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "52"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "53"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "54"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "55"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "56"}}, 1510781846.0, false},
+		{"lustre_job_last_update_time_seconds", "Time in seconds since the epoch the job statistics were last updated.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"jobid", "57"}}, 1510781846.0, false},
 
 		// MGS Metrics
 		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"target", "osd"}, {"component", "mgs"}}, 1.12074688e+09, false},
@@ -1492,195 +1596,213 @@ func TestCollector(t *testing.T) {
 		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 1.120748928e+09, false},
 
 		// Client Metrics
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1537, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.638901e+07, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 117183, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 11916, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1489, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 458648, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 24502, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2876, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.145365e+06, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 51901, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5860, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405497e+08, false},
-		{"lustre_xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.194304e+06, false},
-		{"lustre_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 8.946781e+07, false},
-		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.8300029952e+11, false},
-		{"lustre_maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 64, false},
-		{"lustre_statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.194304e+06, false},
-		{"lustre_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4096, false},
-		{"lustre_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.194304e+06, false},
-		{"lustre_lazystatfs_enabled", "Returns '1' if lazystatfs (a non-blocking alternative to statfs) is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1.048576e+06, false},
-		{"lustre_maximum_ea_size_bytes", "Maximum Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 216, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 64, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 173, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 125471, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 22293, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8.127518e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2566, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.598283e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 336, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 241574, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 35, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 45678, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5268, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2.274739e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 669, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 476715, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 49, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 64199, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 10796, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 4.270417e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1319, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 942102, false},
-		{"lustre_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 9.381379729408e+13, false},
-		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83007085568e+11, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405267e+08, false},
-		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83010362368e+11, false},
-		{"lustre_default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 128, false},
-		{"lustre_maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2, false},
-		{"lustre_maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 64, false},
-		{"lustre_statahead_maximum", "Maximum window size for statahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 32, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "alloc_inode"}, {"target", "lustrefs-ffff88105db50000"}}, 2, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "close"}, {"target", "lustrefs-ffff88105db50000"}}, 96, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "getattr"}, {"target", "lustrefs-ffff88105db50000"}}, 41, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "getxattr"}, {"target", "lustrefs-ffff88105db50000"}}, 85, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "inode_permission"}, {"target", "lustrefs-ffff88105db50000"}}, 398, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "open"}, {"target", "lustrefs-ffff88105db50000"}}, 136, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "readdir"}, {"target", "lustrefs-ffff88105db50000"}}, 12, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "removexattr"}, {"target", "lustrefs-ffff88105db50000"}}, 134, false},
-		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "truncate"}, {"target", "lustrefs-ffff88105db50000"}}, 134, false},
-		{"lustre_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1.048576e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 90, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 13, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 12, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 11, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 9, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 8, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 53, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 1.053804e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 91419, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 18683, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2399, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 174, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 5, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 3.518152e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 4.520192e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 3.656921e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2.35005e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 1.396192e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 832325, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 497409, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 272560, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1537, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1.638901e+07, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 117183, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 11916, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1489, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 458648, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 24502, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 2876, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1.145365e+06, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 51901, false},
+		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 5860, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4.30405497e+08, false},
+		{"lustre_xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4.194304e+06, false},
+		{"lustre_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 8.946781e+07, false},
+		{"lustre_available_kibibytes", "Number of kibibytes readily available in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 2.8300029952e+11, false},
+		{"lustre_maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 64, false},
+		{"lustre_statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_read_maximum_size_bytes", "The maximum read size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4.194304e+06, false},
+		{"lustre_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4096, false},
+		{"lustre_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4.194304e+06, false},
+		{"lustre_lazystatfs_enabled", "Returns '1' if lazystatfs (a non-blocking alternative to statfs) is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1.048576e+06, false},
+		{"lustre_maximum_ea_size_bytes", "Maximum Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 216, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 64, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 173, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 125471, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 8, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 22293, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 8.127518e+06, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 2, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 2566, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1.598283e+06, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 336, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 241574, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 35, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 45678, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 5, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 5268, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 2.274739e+06, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 669, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 476715, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 49, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 64199, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 8, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 10796, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 4.270417e+06, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 0, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 1319, false},
+		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 942102, false},
+		{"lustre_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 9.381379729408e+13, false},
+		{"lustre_free_kibibytes", "Number of kibibytes free in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 2.83007085568e+11, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 4.30405267e+08, false},
+		{"lustre_capacity_kibibytes", "Capacity of the pool in kibibytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 2.83010362368e+11, false},
+		{"lustre_default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 128, false},
+		{"lustre_maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 2, false},
+		{"lustre_maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 64, false},
+		{"lustre_statahead_maximum", "Maximum window size for statahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 32, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "alloc_inode"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 2, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "close"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 96, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "getattr"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 41, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "getxattr"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 85, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "inode_permission"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 398, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "open"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 136, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "readdir"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 12, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "removexattr"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 134, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "truncate"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 134, false},
+		{"lustre_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1.048576e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 90, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 13, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 12, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 11, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 9, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 8, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "mdc"}}, 53, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "1"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "2"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "3"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "4"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "5"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "6"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 1.053804e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 91419, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 18683, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 2399, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 174, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 5, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 2, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 3.518152e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 4.520192e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 3.656921e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 2.35005e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 1.396192e+06, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 832325, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 497409, false},
+		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "OST"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"type", "osc"}}, 272560, false},
+		{"lustre_md_stats_total", "Number of metadata operations the client has performed on the MDT.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "close"}}, 11, false},
+		{"lustre_md_stats_total", "Number of metadata operations the client has performed on the MDT.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "getattr"}}, 1, false},
+		{"lustre_md_stats_total", "Number of metadata operations the client has performed on the MDT.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "intent_lock"}}, 329, false},
+		{"lustre_md_stats_total", "Number of metadata operations the client has performed on the MDT.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "read_page"}}, 6, false},
+		{"lustre_md_stats_total", "Number of metadata operations the client has performed on the MDT.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "setattr"}}, 134, false},
+		{"lustre_lmv_active_targets", "Number of active MDTs in the client's logical metadata volume", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilmv-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_lmv_targets", "Number of MDTs in the client's logical metadata volume", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilmv-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}}, 1, false},
+		{"lustre_lmv_target_active", "Returns '1' if the MDT is active from the client's view", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilmv-ffff88105db50000"}, {"fsname", "lustrefs"}, {"mountpoint", "/lustre/fs"}, {"mdt", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "0"}}, 0, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "1"}}, 90, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "2"}}, 13, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "3"}}, 12, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "4"}}, 11, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "5"}}, 9, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "6"}}, 8, false},
+		{"lustre_modify_rpcs_in_flight_total", "Total number of modify RPCs by the number of modify RPCs in flight when they were sent.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}, {"operation", "modify"}, {"size", "7"}}, 53, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of RPCs in flight to the MDT", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 8, false},
+		{"lustre_maximum_modify_rpcs_in_flight", "Maximum number of modify RPCs in flight to the MDT", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"fsname", "lustrefs"}, {"target_type", "MDT"}, {"target_index", "0"}, {"mountpoint", "/lustre/fs"}}, 7, false},
 
 		// Generic Metrics
 		{"lustre_cache_miss_total", "Total number of cache misses.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
@@ -1698,6 +1820,7 @@ func TestCollector(t *testing.T) {
 		{"lustre_shrinks_total", "Total number of shrinks.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_free_page_low", "Lowest number of free pages reached.", gauge, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_out_of_memory_request_total", "Total number of out of memory requests.", 0, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
+		{"lustre_version_info", "Version of the running Lustre modules, value is always '1'", gauge, []labelPair{{"version", "2.10.1"}}, 1, false},
 
 		// LNET Metrics
 		{"lustre_console_max_delay_centiseconds", "Minimum time in centiseconds before the console logs a message", gauge, []labelPair{{"component", "lnet"}, {"target", "lnet"}}, 60000, false},
@@ -1740,11 +1863,10 @@ func TestCollector(t *testing.T) {
 
 	numParsed := 0
 	for _, target := range targets {
-		toggleCollectors(target)
 		var missingMetrics []promType // Array of metrics that are missing for the given target
 		enabledSources := []string{"procfs", "sys", "sysfs", "lctl"}
 
-		sourceList, errList := loadSources(enabledSources)
+		sourceList, errList := loadSources(enabledSources, targetConfig(target))
 
 		if errList != nil {
 			for _, err := range errList {
@@ -1825,10 +1947,65 @@ func TestCollector(t *testing.T) {
 	if l := len(expectedMetrics); l != numParsed {
 		t.Fatalf("Retrieved an unexpected number of metrics. Expected: %d, Got: %d", l, numParsed)
 	}
+}
+
+func TestDescribe(t *testing.T) {
+	config := fixtureConfig()
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+
+	// The pedantic registry verifies each collected metric against the described descriptors
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(LustreSource{sourceList: sourceList, telemetry: config.Telemetry}); err != nil {
+		t.Fatalf("Failed to register the sources: %s", err)
+	}
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatalf("Failed to gather the described metrics: %s", err)
+	}
+	if len(metricFamilies) == 0 {
+		t.Fatal("No metrics gathered")
+	}
+}
 
-	// Return the proc location to the default value
-	sources.ProcLocation = "/proc"
-	sources.SysLocation = "/sys"
+func TestTargetFilters(t *testing.T) {
+	config := fixtureConfig()
+	filter, err := sources.NewTargetFilter("", "", "", "lustrefs-OST000[2-6]|lustrefs-MDT0000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.TargetFilter = filter
+	sourceList, errList := loadSources([]string{"procfs", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
 
-	sources.LctlCommandMode = true
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(LustreSource{sourceList: sourceList}); err != nil {
+		t.Fatal(err)
+	}
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	targets := map[string]bool{}
+	for _, metricFamily := range metricFamilies {
+		for _, metric := range metricFamily.Metric {
+			for _, label := range metric.Label {
+				if label.GetName() == "target" {
+					targets[label.GetValue()] = true
+				}
+			}
+		}
+	}
+	for _, target := range []string{"lustrefs-OST0002", "lustrefs-OST0004", "lustrefs-OST0006", "lustrefs-MDT0000"} {
+		if targets[target] {
+			t.Fatalf("Retrieved metrics of the filtered target %s", target)
+		}
+	}
+	if !targets["lustrefs-OST0000"] {
+		t.Fatal("Retrieved no metrics of the selected target lustrefs-OST0000")
+	}
 }
@@ -0,0 +1,182 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// pushJobLabel is the label of the job, which is set by the Pushgateway like the grouping labels.
+	pushJobLabel = "job"
+	// pushExportedPrefix is prepended to the labels of the metrics clashing with a grouping label, like Prometheus does.
+	pushExportedPrefix = "exported_"
+
+	pushOnShutdownPush   = "push"
+	pushOnShutdownDelete = "delete"
+)
+
+// metricsPusher pushes the metrics of a collector to a Pushgateway in an interval.
+type metricsPusher struct {
+	collector selectableCollector
+	url       string
+	job       string
+	grouping  map[string]string
+	interval  time.Duration
+	// timeout is the deadline of the collection of the sources, zero for none
+	timeout time.Duration
+	// retries is the number of retries of a failed push or delete,
+	// backoff the wait before the first retry, which is doubled for each further retry up to the interval
+	retries int
+	backoff time.Duration
+	// onShutdown is either pushOnShutdownPush or pushOnShutdownDelete
+	onShutdown string
+}
+
+// newPushGrouping returns the grouping labels given as name and value, e.g. 'fsname=lustrefs'.
+// The instance label defaults to the hostname, so the metrics of several nodes pushed with the same job do not replace each other.
+func newPushGrouping(labels map[string]string) (map[string]string, error) {
+	grouping := map[string]string{}
+	for name, value := range labels {
+		if name == pushJobLabel {
+			return nil, fmt.Errorf("the %s label is given by the job of the push", pushJobLabel)
+		}
+		grouping[name] = value
+	}
+	if _, exists := grouping["instance"]; !exists {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("unable to determine the instance label: %s", err)
+		}
+		grouping["instance"] = hostname
+	}
+	return grouping, nil
+}
+
+// pusher returns the pusher of the given metric families.
+func (p *metricsPusher) pusher(families []*dto.MetricFamily) *push.Pusher {
+	pusher := push.New(p.url, p.job).Gatherer(prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		return families, nil
+	}))
+	for name, value := range p.grouping {
+		pusher = pusher.Grouping(name, value)
+	}
+	return pusher
+}
+
+// collect collects all metrics of the collector, removing the grouping labels.
+func (p *metricsPusher) collect() ([]*dto.MetricFamily, error) {
+	families, err := gatherOnce(p.collector, p.timeout)
+	if err != nil {
+		if len(families) == 0 {
+			return nil, err
+		}
+		log.Errorf("Pushing the metrics gathered despite errors: %s", err)
+	}
+	groupMetrics(families, p.grouping)
+	return families, nil
+}
+
+// groupMetrics removes the labels of the metrics given by the grouping labels, which the Pushgateway adds to all metrics of the group.
+// Labels with a value other than the one of the group, e.g. the fsname of another filesystem, and job labels
+// are renamed with the 'exported_' prefix. Empty ones are equivalent to missing labels and removed instead.
+func groupMetrics(families []*dto.MetricFamily, grouping map[string]string) {
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			labels := metric.Label[:0]
+			for _, label := range metric.Label {
+				name := label.GetName()
+				value, grouped := grouping[name]
+				switch {
+				case name != pushJobLabel && !grouped:
+					labels = append(labels, label)
+				case label.GetValue() == "":
+				case name == pushJobLabel || label.GetValue() != value:
+					exported := pushExportedPrefix + name
+					labels = append(labels, &dto.LabelPair{Name: &exported, Value: label.Value})
+				}
+			}
+			sort.Slice(labels, func(i, j int) bool { return labels[i].GetName() < labels[j].GetName() })
+			metric.Label = labels
+		}
+	}
+}
+
+// withRetry calls the push or delete, retrying a failed one with an exponential backoff until the context is done.
+func (p *metricsPusher) withRetry(ctx context.Context, call func() error) error {
+	backoff := p.backoff
+	for retry := 0; ; retry++ {
+		err := call()
+		if err == nil || retry >= p.retries {
+			return err
+		}
+		log.Warnf("Request to %s failed, retrying in %s - %s", p.url, backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return err
+		}
+		backoff *= 2
+		if backoff > p.interval {
+			backoff = p.interval
+		}
+	}
+}
+
+// pushOnce collects the metrics and pushes them.
+func (p *metricsPusher) pushOnce(ctx context.Context) error {
+	begin := time.Now()
+	families, err := p.collect()
+	if err != nil {
+		return err
+	}
+	if err := p.withRetry(ctx, p.pusher(families).Push); err != nil {
+		return err
+	}
+	log.Debugf("Pushed %d metric families to %s after %f seconds", len(families), p.url, time.Since(begin).Seconds())
+	return nil
+}
+
+// run pushes the metrics in the interval until the context is done,
+// then pushes the metrics a last time or deletes them from the Pushgateway.
+func (p *metricsPusher) run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if err := p.pushOnce(ctx); err != nil {
+			log.Errorf("Unable to push metrics to %s: %s", p.url, err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return p.shutdown()
+		}
+	}
+}
+
+// shutdown pushes the metrics a last time or deletes them, retrying until the next interval at the latest.
+func (p *metricsPusher) shutdown() error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.interval)
+	defer cancel()
+	if p.onShutdown == pushOnShutdownDelete {
+		log.Infof("Deleting the metrics from %s", p.url)
+		return p.withRetry(ctx, p.pusher(nil).Delete)
+	}
+	log.Infof("Pushing the metrics a last time to %s", p.url)
+	return p.pushOnce(ctx)
+}
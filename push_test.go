@@ -0,0 +1,225 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// pushRequest is a request received by the Pushgateway stand-in.
+type pushRequest struct {
+	method   string
+	path     string
+	families map[string]*dto.MetricFamily
+}
+
+// pushgateway is a stand-in of a Pushgateway recording the requests, failing the given number of first requests.
+type pushgateway struct {
+	mutex    sync.Mutex
+	requests []pushRequest
+	failures int
+}
+
+func (g *pushgateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	request := pushRequest{method: r.Method, path: r.URL.Path}
+	if r.Method != http.MethodDelete {
+		families := map[string]*dto.MetricFamily{}
+		decoder := expfmt.NewDecoder(r.Body, expfmt.ResponseFormat(r.Header))
+		for {
+			family := &dto.MetricFamily{}
+			if err := decoder.Decode(family); err != nil {
+				break
+			}
+			families[family.GetName()] = family
+		}
+		request.families = families
+	}
+	g.requests = append(g.requests, request)
+	if len(g.requests) <= g.failures {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	// The Pushgateway accepts pushes and deletes asynchronously
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (g *pushgateway) received() []pushRequest {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return append([]pushRequest(nil), g.requests...)
+}
+
+func newTestPusher(t *testing.T, url string) *metricsPusher {
+	config := fixtureConfig()
+	config.Client = "disabled"
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+	return &metricsPusher{
+		collector:  LustreSource{sourceList: sourceList},
+		url:        url,
+		job:        "lustre_exporter",
+		grouping:   map[string]string{"instance": "oss1", "fsname": "lustrefs"},
+		interval:   time.Minute,
+		timeout:    time.Minute,
+		retries:    2,
+		backoff:    time.Millisecond,
+		onShutdown: pushOnShutdownDelete,
+	}
+}
+
+func TestPushRetry(t *testing.T) {
+	gateway := &pushgateway{failures: 2}
+	server := httptest.NewServer(gateway)
+	defer server.Close()
+	pusher := newTestPusher(t, server.URL)
+
+	if err := pusher.pushOnce(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	requests := gateway.received()
+	if l := len(requests); l != 3 {
+		t.Fatalf("Retrieved an unexpected number of requests. Expected: %d, Got: %d", 3, l)
+	}
+	request := requests[2]
+	if request.method != http.MethodPut {
+		t.Fatalf("Retrieved an unexpected method. Expected: %s, Got: %s", http.MethodPut, request.method)
+	}
+	// The order of the grouping labels within the path is not fixed
+	paths := map[string]bool{
+		"/metrics/job/lustre_exporter/fsname/lustrefs/instance/oss1": true,
+		"/metrics/job/lustre_exporter/instance/oss1/fsname/lustrefs": true,
+	}
+	if !paths[request.path] {
+		t.Fatalf("Retrieved an unexpected path: %s", request.path)
+	}
+	for _, name := range []string{"lustre_job_stats_total", "lustre_exporter_scrape_duration_seconds"} {
+		if _, exists := request.families[name]; !exists {
+			t.Fatalf("Retrieved no %s from the push", name)
+		}
+	}
+	for _, metric := range request.families["lustre_job_stats_total"].Metric {
+		for _, label := range metric.Label {
+			if label.GetName() == "fsname" {
+				t.Fatalf("Retrieved a metric with the fsname grouping label: %s", metric)
+			}
+		}
+	}
+
+	gateway.mutex.Lock()
+	gateway.failures = 10
+	gateway.mutex.Unlock()
+	if err := pusher.pushOnce(context.Background()); err == nil {
+		t.Fatal("An error was expected after all retries failed, but not received")
+	}
+	if l := len(gateway.received()); l != 6 {
+		t.Fatalf("Retrieved an unexpected number of requests. Expected: %d, Got: %d", 6, l)
+	}
+}
+
+func TestPushShutdown(t *testing.T) {
+	for _, onShutdown := range []string{pushOnShutdownDelete, pushOnShutdownPush} {
+		gateway := &pushgateway{}
+		server := httptest.NewServer(gateway)
+		pusher := newTestPusher(t, server.URL)
+		pusher.onShutdown = onShutdown
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- pusher.run(ctx)
+		}()
+		for len(gateway.received()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+		server.Close()
+
+		expected := []string{http.MethodPut, http.MethodPut}
+		if onShutdown == pushOnShutdownDelete {
+			expected = []string{http.MethodPut, http.MethodDelete}
+		}
+		var methods []string
+		for _, request := range gateway.received() {
+			methods = append(methods, request.method)
+		}
+		if !reflect.DeepEqual(methods, expected) {
+			t.Fatalf("Retrieved unexpected requests on %s. Expected: %v, Got: %v", onShutdown, expected, methods)
+		}
+	}
+}
+
+func TestGroupMetrics(t *testing.T) {
+	label := func(name string, value string) *dto.LabelPair {
+		return &dto.LabelPair{Name: &name, Value: &value}
+	}
+	families := []*dto.MetricFamily{{Metric: []*dto.Metric{
+		{Label: []*dto.LabelPair{label("component", "ost"), label("fsname", "lustrefs")}},
+		{Label: []*dto.LabelPair{label("component", "ost"), label("fsname", "scratch")}},
+		{Label: []*dto.LabelPair{label("job", "29")}},
+		{Label: []*dto.LabelPair{label("component", "mgs"), label("fsname", ""), label("job", "")}},
+	}}}
+	groupMetrics(families, map[string]string{"instance": "oss1", "fsname": "lustrefs"})
+
+	expected := [][]string{
+		{"component=ost"},
+		{"component=ost", "exported_fsname=scratch"},
+		{"exported_job=29"},
+		{"component=mgs"},
+	}
+	for i, metric := range families[0].Metric {
+		var labels []string
+		for _, label := range metric.Label {
+			labels = append(labels, label.GetName()+"="+label.GetValue())
+		}
+		if !reflect.DeepEqual(labels, expected[i]) {
+			t.Fatalf("Retrieved unexpected labels. Expected: %v, Got: %v", expected[i], labels)
+		}
+	}
+}
+
+func TestNewPushGrouping(t *testing.T) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	grouping, err := newPushGrouping(map[string]string{"role": "client"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := map[string]string{"role": "client", "instance": hostname}; !reflect.DeepEqual(grouping, expected) {
+		t.Fatalf("Retrieved unexpected grouping labels. Expected: %v, Got: %v", expected, grouping)
+	}
+	grouping, err = newPushGrouping(map[string]string{"instance": "client1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := map[string]string{"instance": "client1"}; !reflect.DeepEqual(grouping, expected) {
+		t.Fatalf("Retrieved unexpected grouping labels. Expected: %v, Got: %v", expected, grouping)
+	}
+	if _, err := newPushGrouping(map[string]string{"job": "lustre"}); err == nil {
+		t.Fatal("An error was expected for a job grouping label, but not received")
+	}
+}
@@ -0,0 +1,198 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	log "github.com/sirupsen/logrus"
+)
+
+// The directories of a replay archive, which have the same layout as the test fixtures.
+const (
+	replayProcDir = "proc"
+	replaySysDir  = "sys"
+	replayLctlDir = "lctl"
+)
+
+// startReplay extracts the replay archive into a temporary directory,
+// the sources read the directory given by replayPaths instead of the local node.
+func startReplay(archive string) (string, error) {
+	dir, err := ioutil.TempDir("", "lustre_exporter_replay")
+	if err != nil {
+		return "", err
+	}
+	if err := extractReplayArchive(archive, dir); err != nil {
+		removeReplayDir(dir)
+		return "", fmt.Errorf("unable to extract replay archive %s: %s", archive, err)
+	}
+	return dir, nil
+}
+
+// removeReplayDirOnSignal removes the replay directory and exits on SIGINT and SIGTERM,
+// which would otherwise be left behind by the server running until it is killed.
+func removeReplayDirOnSignal(dir string) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		log.Infof("Received %s, removing replay directory %s", sig, dir)
+		removeReplayDir(dir)
+		os.Exit(0)
+	}()
+}
+
+// removeReplayDirOnFatal removes the directory before the exporter exits on a fatal error,
+// which skips the deferred removal.
+func removeReplayDirOnFatal(dir string) {
+	log.RegisterExitHandler(func() {
+		removeReplayDir(dir)
+	})
+}
+
+func removeReplayDir(dir string) {
+	if err := os.RemoveAll(dir); err != nil {
+		log.Warnf("Unable to remove replay directory %s: %s", dir, err)
+	}
+}
+
+// replayPaths returns the paths of the proc, sys and lctl trees within the directory.
+// The debugfs tree is read from 'sys/kernel/debug' and the mount table from 'proc/self/mountinfo'.
+func replayPaths(dir string) sources.Paths {
+	return sources.Paths{
+		Proc:  filepath.Join(dir, replayProcDir),
+		Sys:   filepath.Join(dir, replaySysDir),
+		Debug: filepath.Join(dir, replaySysDir, "kernel/debug"),
+		Lctl:  filepath.Join(dir, replayLctlDir),
+	}
+}
+
+// extractReplayArchive extracts the directories, regular files and symbolic links of the .tar.gz archive into the directory.
+// Lustre links between its trees, e.g. from 'lov' to 'lod', so relative links are kept as long as they stay within the directory.
+// Entries outside of the directory are rejected, other entry types are skipped.
+func extractReplayArchive(archive string, dir string) error {
+	file, err := os.Open(filepath.Clean(archive))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	// The links are created after all other entries, so no entry is extracted through a link
+	var links []*tar.Header
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := filepath.FromSlash(header.Name)
+		if !withinReplayDir(name) {
+			return fmt.Errorf("invalid entry %q outside of the archive", header.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0750); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+				return err
+			}
+			if err := extractReplayFile(tarReader, path); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			linkname := filepath.FromSlash(header.Linkname)
+			if filepath.IsAbs(linkname) || !withinReplayDir(filepath.Join(filepath.Dir(name), linkname)) {
+				return fmt.Errorf("invalid link %q of entry %q outside of the archive", header.Linkname, header.Name)
+			}
+			links = append(links, header)
+		default:
+			log.Debugf("Skipping replay archive entry %s of type %c", header.Name, header.Typeflag)
+		}
+	}
+
+	for _, header := range links {
+		name := filepath.FromSlash(header.Name)
+		// A link within the path of another link could point outside of the directory
+		linked, err := containsLink(dir, filepath.Dir(name))
+		if err != nil {
+			return err
+		}
+		if linked {
+			return fmt.Errorf("invalid entry %q within a link", header.Name)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		if err := os.Symlink(filepath.FromSlash(header.Linkname), path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// containsLink returns whether any existing element of the relative path within the directory is a symbolic link.
+func containsLink(dir string, name string) (bool, error) {
+	path := dir
+	for _, element := range strings.Split(filepath.Clean(name), string(filepath.Separator)) {
+		path = filepath.Join(path, element)
+		info, err := os.Lstat(path)
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// withinReplayDir returns whether the relative path stays within the directory it is relative to.
+func withinReplayDir(name string) bool {
+	name = filepath.Clean(name)
+	return !filepath.IsAbs(name) && name != ".." && !strings.HasPrefix(name, ".."+string(filepath.Separator))
+}
+
+func extractReplayFile(reader io.Reader, path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, reader); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,182 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+type archiveEntry struct {
+	header  tar.Header
+	content string
+}
+
+func writeTestArchive(t *testing.T, archive string, entries []archiveEntry) {
+	file, err := os.Create(archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		entry.header.Size = int64(len(entry.content))
+		if err := tarWriter.WriteHeader(&entry.header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(entry.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fixtureEntries returns the archive entries of the files within the fixture directories.
+func fixtureEntries(t *testing.T, dirs ...string) []archiveEntry {
+	var entries []archiveEntry
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			if info.Mode()&os.ModeSymlink != 0 {
+				linkname, err := os.Readlink(path)
+				if err != nil {
+					return err
+				}
+				entries = append(entries, archiveEntry{tar.Header{Name: filepath.ToSlash(path), Linkname: linkname, Typeflag: tar.TypeSymlink}, ""})
+				return nil
+			}
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, archiveEntry{tar.Header{Name: filepath.ToSlash(path), Mode: 0644, Typeflag: tar.TypeReg}, string(content)})
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return entries
+}
+
+// gatherSampleNames returns the number of samples by metric name of the sources.
+func gatherSampleNames(t *testing.T, paths sources.Paths) map[string]int {
+	config := fixtureConfig()
+	config.Paths = paths
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(LustreSource{sourceList: sourceList}); err != nil {
+		t.Fatal(err)
+	}
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	samples := map[string]int{}
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "lustre_exporter_scrape_duration_seconds" {
+			samples[metricFamily.GetName()] = len(metricFamily.Metric)
+		}
+	}
+	return samples
+}
+
+func TestReplay(t *testing.T) {
+	expected := gatherSampleNames(t, fixturePaths)
+
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := filepath.Join(dir, "node.tar.gz")
+	writeTestArchive(t, archive, fixtureEntries(t, "proc", "sys", "lctl"))
+
+	replayDir := filepath.Join(dir, "replay")
+	if err := extractReplayArchive(archive, replayDir); err != nil {
+		t.Fatal(err)
+	}
+	if samples := gatherSampleNames(t, replayPaths(replayDir)); !reflect.DeepEqual(samples, expected) {
+		t.Fatalf("Retrieved unexpected samples from the replay archive. Expected: %v, Got: %v", expected, samples)
+	}
+}
+
+func TestExtractReplayArchiveOutside(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, header := range []tar.Header{
+		{Name: "../outside", Mode: 0644, Typeflag: tar.TypeReg},
+		{Name: "/proc/outside", Mode: 0644, Typeflag: tar.TypeReg},
+		{Name: "proc/../../outside", Mode: 0644, Typeflag: tar.TypeReg},
+		{Name: "proc/fs/lustre/lov", Linkname: "../../../../outside", Typeflag: tar.TypeSymlink},
+		{Name: "proc/fs/lustre/lov", Linkname: "/outside", Typeflag: tar.TypeSymlink},
+	} {
+		archive := filepath.Join(dir, "node.tar.gz")
+		writeTestArchive(t, archive, []archiveEntry{{header, ""}})
+		if err := extractReplayArchive(archive, filepath.Join(dir, "replay")); err == nil {
+			t.Fatalf("Extracted the entry %q linking to %q outside of the archive", header.Name, header.Linkname)
+		}
+	}
+
+	// The second link would point outside of the directory through the first one
+	archive := filepath.Join(dir, "node.tar.gz")
+	writeTestArchive(t, archive, []archiveEntry{
+		{tar.Header{Name: "proc/fs/root", Linkname: "../..", Typeflag: tar.TypeSymlink}, ""},
+		{tar.Header{Name: "proc/fs/root/outside", Linkname: "..", Typeflag: tar.TypeSymlink}, ""},
+	})
+	if err := extractReplayArchive(archive, filepath.Join(dir, "chained")); err == nil {
+		t.Fatal("Extracted a link within another link")
+	}
+}
+
+func TestRemoveReplayDirOnFatal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	removeReplayDirOnFatal(dir)
+
+	var exitCode int
+	logger := log.StandardLogger()
+	defer func(exit func(int)) { logger.ExitFunc = exit }(logger.ExitFunc)
+	logger.ExitFunc = func(code int) { exitCode = code }
+	log.Fatal("Unable to load sources")
+
+	if exitCode != 1 {
+		t.Fatalf("Retrieved an unexpected exit code. Expected: 1, Got: %d", exitCode)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("Expected the replay directory to be removed on a fatal error, Got: %v", err)
+	}
+}
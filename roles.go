@@ -0,0 +1,161 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+var nodeRoleDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(sources.Namespace, "node", "role_info"),
+	"Role of the node detected from the Lustre devices present, value is always '1'",
+	[]string{"role"},
+	nil,
+)
+
+// applyNodeRoles returns the config with the OST, MDT, MGS, MDS and client collectors matching the node roles
+// enabled with their configured level and the others disabled.
+func applyNodeRoles(roles []string, config sources.Config) sources.Config {
+	applied := config
+	applied.Ost = "disabled"
+	applied.Mdt = "disabled"
+	applied.Mgs = "disabled"
+	applied.Mds = "disabled"
+	applied.Client = "disabled"
+	for _, role := range roles {
+		switch role {
+		case sources.RoleOST:
+			applied.Ost = config.Ost
+		case sources.RoleMDT:
+			applied.Mdt = config.Mdt
+			applied.Mds = config.Mds
+		case sources.RoleQMT:
+			// The quota master target runs on the MDT
+			applied.Mdt = config.Mdt
+		case sources.RoleMGS:
+			applied.Mgs = config.Mgs
+		case sources.RoleClient:
+			applied.Client = config.Client
+		}
+	}
+	return applied
+}
+
+// autoSource contains the sources loaded for the detected node roles, which are reloaded if the roles change.
+type autoSource struct {
+	mu          sync.RWMutex
+	sourceNames []string
+	// config contains the configured levels, which are applied to the detected node roles
+	config sources.Config
+	roles  []string
+	source LustreSource
+	// background contains the intervals of the background collection, nil if the sources are collected on scrape
+	background *backgroundIntervals
+}
+
+func newAutoSource(sourceNames []string, config sources.Config, background *backgroundIntervals) (*autoSource, []error) {
+	a := &autoSource{sourceNames: sourceNames, config: config, background: background}
+	if errList := a.reload(sources.DetectNodeRoles(config.Paths)); errList != nil {
+		return nil, errList
+	}
+	return a, nil
+}
+
+func (a *autoSource) reload(roles []string) []error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	sourceList, errList := loadSources(a.sourceNames, applyNodeRoles(roles, a.config))
+	if errList != nil {
+		return errList
+	}
+	if a.background != nil {
+		stopBackgroundCollection(a.source.sourceList)
+		sourceList = startBackgroundCollection(sourceList, a.background)
+	}
+	a.roles = roles
+	a.source = LustreSource{sourceList: sourceList, telemetry: a.config.Telemetry}
+	return nil
+}
+
+// check detects the node roles again and reloads the sources if the roles changed, e.g. after a failover.
+func (a *autoSource) check() {
+	roles := sources.DetectNodeRoles(a.config.Paths)
+	a.mu.RLock()
+	changed := !reflect.DeepEqual(roles, a.roles)
+	previous := a.roles
+	a.mu.RUnlock()
+	if !changed {
+		return
+	}
+	log.Infof("Node roles changed from %v to %v", previous, roles)
+	if errList := a.reload(roles); errList != nil {
+		for _, err := range errList {
+			log.Errorf("Couldn't reload source: %s", err)
+		}
+		return
+	}
+	logCollectorStates(applyNodeRoles(roles, a.config))
+}
+
+// run checks the node roles in the given interval.
+func (a *autoSource) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		a.check()
+	}
+}
+
+// current returns the node roles and the sources loaded for them.
+// The lock is only held while copying them, so a reload does not wait for slow or timed out scrapes.
+func (a *autoSource) current() ([]string, LustreSource) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.roles, a.source
+}
+
+// Describe implements the prometheus.Describe interface
+func (a *autoSource) Describe(ch chan<- *prometheus.Desc) {
+	ch <- nodeRoleDesc
+	_, source := a.current()
+	source.Describe(ch)
+}
+
+// Collect implements the prometheus.Collect interface
+func (a *autoSource) Collect(ch chan<- prometheus.Metric) {
+	a.collectContext(context.Background(), ch)
+}
+
+// collectContext collects the node roles and the metrics of the sources loaded for them.
+func (a *autoSource) collectContext(ctx context.Context, ch chan<- prometheus.Metric) {
+	roles, source := a.current()
+	for _, role := range roles {
+		ch <- prometheus.MustNewConstMetric(nodeRoleDesc, prometheus.GaugeValue, 1, role)
+	}
+	source.collectContext(ctx, ch)
+}
+
+// collectors returns the sorted names of the collectors of the sources loaded for the current node roles.
+func (a *autoSource) collectors() []string {
+	_, source := a.current()
+	return source.collectors()
+}
+
+// collectSelected collects the metrics of the selected collectors from the sources loaded for the current node roles.
+func (a *autoSource) collectSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection) {
+	_, source := a.current()
+	source.collectSelected(ctx, ch, selection)
+}
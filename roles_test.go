@@ -0,0 +1,111 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestApplyNodeRoles(t *testing.T) {
+	var config sources.Config
+	config.Ost, config.Mdt, config.Mgs, config.Mds, config.Client = "core", "extended", "core", "extended", "core"
+
+	applied := applyNodeRoles([]string{sources.RoleOST}, config)
+	got := []string{applied.Ost, applied.Mdt, applied.Mgs, applied.Mds, applied.Client}
+	if expected := []string{"core", "disabled", "disabled", "disabled", "disabled"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Retrieved unexpected collector states for an OSS. Expected: %v, Got: %v", expected, got)
+	}
+
+	applied = applyNodeRoles([]string{sources.RoleMDT, sources.RoleMGS, sources.RoleQMT}, config)
+	got = []string{applied.Ost, applied.Mdt, applied.Mgs, applied.Mds, applied.Client}
+	if expected := []string{"disabled", "extended", "core", "extended", "disabled"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Retrieved unexpected collector states for a MDS. Expected: %v, Got: %v", expected, got)
+	}
+
+	applied = applyNodeRoles(nil, config)
+	got = []string{applied.Ost, applied.Mdt, applied.Mgs, applied.Mds, applied.Client}
+	if expected := []string{"disabled", "disabled", "disabled", "disabled", "disabled"}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Retrieved unexpected collector states without roles. Expected: %v, Got: %v", expected, got)
+	}
+}
+
+func TestAutoSource(t *testing.T) {
+	config := fixtureConfig()
+	config.Ost, config.Mdt, config.Mgs, config.Mds, config.Client = "core", "core", "core", "core", "core"
+	a, errList := newAutoSource([]string{"procfs"}, config, nil)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+
+	expected := []string{sources.RoleOST, sources.RoleMDT, sources.RoleMGS, sources.RoleQMT, sources.RoleClient}
+	if !reflect.DeepEqual(a.roles, expected) {
+		t.Fatalf("Retrieved unexpected node roles. Expected: %v, Got: %v", expected, a.roles)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(a)
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var roles []string
+	for _, metricFamily := range metricFamilies {
+		if metricFamily.GetName() != "lustre_node_role_info" {
+			continue
+		}
+		for _, metric := range metricFamily.Metric {
+			roles = append(roles, metric.Label[0].GetValue())
+		}
+	}
+	if expected := []string{"client", "mdt", "mgs", "ost", "qmt"}; !reflect.DeepEqual(roles, expected) {
+		t.Fatalf("Retrieved unexpected role metrics. Expected: %v, Got: %v", expected, roles)
+	}
+
+	a.check()
+	if !reflect.DeepEqual(a.roles, expected) {
+		t.Fatalf("Node roles changed unexpectedly: %v", a.roles)
+	}
+}
+
+func TestAutoSourceReloadDuringScrape(t *testing.T) {
+	config := fixtureConfig()
+	a, errList := newAutoSource([]string{"procfs"}, config, nil)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+
+	// The scrape blocks on sending its first metric, like a slow scrape
+	ch := make(chan prometheus.Metric)
+	go a.collectContext(context.Background(), ch)
+	<-ch
+
+	reloaded := make(chan []error)
+	go func() {
+		reloaded <- a.reload([]string{sources.RoleClient})
+	}()
+	select {
+	case errList := <-reloaded:
+		if errList != nil {
+			t.Fatal(errList)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("The reload waited for the scrape in progress")
+	}
+	// Drain the abandoned scrape
+	go func() {
+		for range ch {
+		}
+	}()
+}
@@ -0,0 +1,235 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	snapshotAgeDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(sources.Namespace, "exporter", "snapshot_age_seconds"),
+		"lustre_exporter: Age of the snapshot served from the background collection by source.",
+		[]string{"source"},
+		nil,
+	)
+	snapshotDurationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(sources.Namespace, "exporter", "snapshot_duration_seconds"),
+		"lustre_exporter: Duration of the background collection of the snapshot served by source.",
+		[]string{"source"},
+		nil,
+	)
+)
+
+// backgroundIntervals contains the intervals of the background collection of the sources.
+type backgroundIntervals struct {
+	defaultInterval time.Duration
+	sources         map[string]time.Duration
+	// timeout is the deadline of a single collection of a source, zero for none
+	timeout time.Duration
+}
+
+// newBackgroundIntervals parses the intervals of single sources given as name and duration, e.g. 'procfs=1m'.
+func newBackgroundIntervals(defaultInterval time.Duration, sourceIntervals map[string]string) (*backgroundIntervals, error) {
+	if defaultInterval <= 0 {
+		return nil, fmt.Errorf("invalid background interval %s", defaultInterval)
+	}
+	b := &backgroundIntervals{defaultInterval: defaultInterval, sources: map[string]time.Duration{}}
+	for name, value := range sourceIntervals {
+		if _, exists := sources.Factories[name]; !exists {
+			return nil, fmt.Errorf("background interval of unknown source %q", name)
+		}
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return nil, fmt.Errorf("invalid background interval %q of source %q", value, name)
+		}
+		b.sources[name] = interval
+	}
+	return b, nil
+}
+
+func (b *backgroundIntervals) interval(name string) time.Duration {
+	if interval, exists := b.sources[name]; exists {
+		return interval
+	}
+	return b.defaultInterval
+}
+
+// snapshotSource collects a source in the background and serves the metrics of the latest snapshot,
+// so scrapes never read from Lustre themselves.
+// The metrics are kept by collector, so scrapes selecting collectors are served from the snapshot as well.
+type snapshotSource struct {
+	name     string
+	source   sources.LustreSource
+	interval time.Duration
+	timeout  time.Duration
+	ready    chan struct{}
+	stop     chan struct{}
+
+	mu       sync.RWMutex
+	metrics  map[string][]prometheus.Metric
+	time     time.Time
+	duration time.Duration
+}
+
+func newSnapshotSource(name string, source sources.LustreSource, interval time.Duration, timeout time.Duration) *snapshotSource {
+	return &snapshotSource{
+		name:     name,
+		source:   source,
+		interval: interval,
+		timeout:  timeout,
+		ready:    make(chan struct{}),
+		stop:     make(chan struct{}),
+	}
+}
+
+// startBackgroundCollection wraps the sources into snapshot sources collected in their interval.
+func startBackgroundCollection(sourceList map[string]sources.LustreSource, intervals *backgroundIntervals) map[string]sources.LustreSource {
+	snapshotList := make(map[string]sources.LustreSource, len(sourceList))
+	for name, source := range sourceList {
+		s := newSnapshotSource(name, source, intervals.interval(name), intervals.timeout)
+		go s.run()
+		snapshotList[name] = s
+	}
+	return snapshotList
+}
+
+// stopBackgroundCollection stops the background collection of the snapshot sources within the list.
+func stopBackgroundCollection(sourceList map[string]sources.LustreSource) {
+	for _, source := range sourceList {
+		if s, ok := source.(*snapshotSource); ok {
+			close(s.stop)
+		}
+	}
+}
+
+// run collects the source immediately and then in the interval until the source is stopped.
+// A collection in progress is cancelled when the source is stopped.
+func (s *snapshotSource) run() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.stop
+		cancel()
+	}()
+
+	s.collect(ctx)
+	close(s.ready)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.collect(ctx)
+		case <-s.stop:
+			return
+		}
+	}
+}
+
+// collect updates each collector of the source and replaces the snapshot.
+// A collection blocked on a file read delays the next snapshot, which is reported by its age.
+// Errors are logged once here instead of on every scrape of the snapshot.
+func (s *snapshotSource) collect(ctx context.Context) {
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+	begin := time.Now()
+	metrics := map[string][]prometheus.Metric{}
+	var messages []string
+	for _, collector := range s.source.Collectors() {
+		collected, err := gatherMetrics(func(ch chan<- prometheus.Metric) error {
+			return s.source.UpdateSelected(ctx, ch, sources.Selection{collector: true})
+		})
+		metrics[collector] = collected
+		if err != nil {
+			messages = append(messages, fmt.Sprintf("%s: %s", collector, err))
+		}
+	}
+	duration := time.Since(begin)
+
+	if messages != nil {
+		log.Errorf("background collection of source %q failed after %f seconds - %s", s.name, duration.Seconds(), strings.Join(messages, "; "))
+	} else {
+		log.Debugf("background collection of source %q succeeded after %f seconds", s.name, duration.Seconds())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metrics = metrics
+	s.time = begin.Add(duration)
+	s.duration = duration
+}
+
+// gatherMetrics returns the metrics sent by the update.
+func gatherMetrics(update func(ch chan<- prometheus.Metric) error) ([]prometheus.Metric, error) {
+	ch := make(chan prometheus.Metric)
+	done := make(chan []prometheus.Metric)
+	go func() {
+		var metrics []prometheus.Metric
+		for metric := range ch {
+			metrics = append(metrics, metric)
+		}
+		done <- metrics
+	}()
+	err := update(ch)
+	close(ch)
+	return <-done, err
+}
+
+func (s *snapshotSource) Update(ctx context.Context, ch chan<- prometheus.Metric) error {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+// UpdateSelected sends the metrics of the selected collectors from the latest snapshot,
+// waiting for the first snapshot if there is none yet.
+// The snapshot is copied before sending, so a slow scrape does not block the background collection.
+func (s *snapshotSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection) error {
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	var selected []prometheus.Metric
+	s.mu.RLock()
+	for collector, metrics := range s.metrics {
+		if selection != nil && !selection[collector] {
+			continue
+		}
+		selected = append(selected, metrics...)
+	}
+	snapshotTime, duration := s.time, s.duration
+	s.mu.RUnlock()
+
+	for _, metric := range selected {
+		ch <- metric
+	}
+	ch <- prometheus.MustNewConstMetric(snapshotAgeDesc, prometheus.GaugeValue, time.Since(snapshotTime).Seconds(), s.name)
+	ch <- prometheus.MustNewConstMetric(snapshotDurationDesc, prometheus.GaugeValue, duration.Seconds(), s.name)
+	return nil
+}
+
+func (s *snapshotSource) Describe(ch chan<- *prometheus.Desc) {
+	s.source.Describe(ch)
+	ch <- snapshotAgeDesc
+	ch <- snapshotDurationDesc
+}
+
+func (s *snapshotSource) Collectors() []string {
+	return s.source.Collectors()
+}
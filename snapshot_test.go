@@ -0,0 +1,214 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/sources"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+var countingDesc = prometheus.NewDesc("lustre_counting_test", "Test", []string{"collector"}, nil)
+
+// countingSource counts the updates of each collector, which fail with the error of the source.
+type countingSource struct {
+	updates int64
+	err     error
+}
+
+func (s *countingSource) Update(ctx context.Context, ch chan<- prometheus.Metric) error {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+func (s *countingSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection) error {
+	for _, collector := range s.Collectors() {
+		if selection == nil || selection[collector] {
+			atomic.AddInt64(&s.updates, 1)
+			ch <- prometheus.MustNewConstMetric(countingDesc, prometheus.GaugeValue, 1, collector)
+		}
+	}
+	return s.err
+}
+
+func (s *countingSource) Describe(ch chan<- *prometheus.Desc) {
+	ch <- countingDesc
+}
+
+func (s *countingSource) Collectors() []string {
+	return []string{"ost", sources.JobStatsCollector}
+}
+
+func TestSnapshotSource(t *testing.T) {
+	source := &countingSource{}
+	sourceList := startBackgroundCollection(map[string]sources.LustreSource{"counting": source}, &backgroundIntervals{defaultInterval: time.Hour})
+	defer stopBackgroundCollection(sourceList)
+	l := LustreSource{sourceList: sourceList}
+
+	// Concurrent scrapes are served from the same snapshot without updating the source
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ch := make(chan prometheus.Metric, 10)
+			l.collectSelected(context.Background(), ch, nil)
+		}()
+	}
+	wg.Wait()
+	if updates := atomic.LoadInt64(&source.updates); updates != 2 {
+		t.Fatalf("Unexpected number of collector updates. Expected: 2, Got: %d", updates)
+	}
+
+	ch := make(chan prometheus.Metric, 10)
+	l.collectSelected(context.Background(), ch, sources.Selection{sources.JobStatsCollector: true})
+	close(ch)
+	var names []string
+	for metric := range ch {
+		names = append(names, descName(metric.Desc()))
+	}
+	expected := []string{"lustre_counting_test", "lustre_exporter_snapshot_age_seconds", "lustre_exporter_snapshot_duration_seconds"}
+	if len(names) != len(expected) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %v, Got: %v", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Fatalf("Retrieved unexpected metrics. Expected: %v, Got: %v", expected, names)
+		}
+	}
+
+	if count, err := testutil.GatherAndCount(newSourceRegistry(t, l), "lustre_exporter_snapshot_age_seconds"); err != nil || count != 1 {
+		t.Fatalf("Expected a single snapshot age, Got: %d - %v", count, err)
+	}
+}
+
+func TestSnapshotSourceSlowScrape(t *testing.T) {
+	s := newSnapshotSource("counting", &countingSource{err: errors.New("failed")}, time.Hour, 0)
+	go s.run()
+	defer close(s.stop)
+
+	// A scrape no longer reading the metrics does not block the next collection
+	ch := make(chan prometheus.Metric)
+	scraped := make(chan error, 1)
+	go func() {
+		scraped <- s.UpdateSelected(context.Background(), ch, nil)
+	}()
+	<-ch
+	collected := make(chan struct{})
+	go func() {
+		s.collect(context.Background())
+		close(collected)
+	}()
+	select {
+	case <-collected:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Collection blocked by a slow scrape")
+	}
+
+	// The failed collection is logged once instead of failing every scrape of the snapshot
+	for i := 0; i < 3; i++ {
+		<-ch
+	}
+	if err := <-scraped; err != nil {
+		t.Fatalf("Unexpected error of a scrape of the snapshot: %s", err)
+	}
+}
+
+func newSourceRegistry(t *testing.T, l LustreSource) *prometheus.Registry {
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(selectedCollector{ctx: context.Background(), collector: l}); err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+// descName extracts the fully-qualified metric name from the descriptor.
+func descName(desc *prometheus.Desc) string {
+	s := desc.String()
+	start := len(`Desc{fqName: "`)
+	for end := start; end < len(s); end++ {
+		if s[end] == '"' {
+			return s[start:end]
+		}
+	}
+	return s
+}
+
+func TestNewBackgroundIntervals(t *testing.T) {
+	intervals, err := newBackgroundIntervals(time.Minute, map[string]string{"procfs": "5m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if intervals.interval("procfs") != 5*time.Minute || intervals.interval("sysfs") != time.Minute {
+		t.Fatalf("Retrieved unexpected intervals: %v", intervals)
+	}
+	for _, sourceIntervals := range []map[string]string{{"unknown": "1m"}, {"procfs": "often"}, {"procfs": "-1m"}} {
+		if _, err := newBackgroundIntervals(time.Minute, sourceIntervals); err == nil {
+			t.Fatalf("Expected an error for the intervals %v", sourceIntervals)
+		}
+	}
+	if _, err := newBackgroundIntervals(0, nil); err == nil {
+		t.Fatal("Expected an error for a zero interval")
+	}
+}
+
+// blockingSource sends a metric and blocks until it is released, like a read from a stuck target.
+type blockingSource struct {
+	countingSource
+	release chan struct{}
+}
+
+func (s *blockingSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection sources.Selection) error {
+	ch <- prometheus.MustNewConstMetric(countingDesc, prometheus.GaugeValue, 1, "ost")
+	<-s.release
+	ch <- prometheus.MustNewConstMetric(countingDesc, prometheus.GaugeValue, 1, "jobstats")
+	return nil
+}
+
+func TestCollectFromSourceTimeout(t *testing.T) {
+	source := &blockingSource{release: make(chan struct{})}
+	defer close(source.release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	ch := make(chan prometheus.Metric, 10)
+	collectFromSource(ctx, "blocking", source, ch, nil)
+	close(ch)
+	if count := len(ch); count != 1 {
+		t.Fatalf("Retrieved an unexpected number of metrics before the timeout. Expected: 1, Got: %d", count)
+	}
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(scrapeDurations)
+	metricFamilies, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, metricFamily := range metricFamilies {
+		for _, metric := range metricFamily.Metric {
+			labels := map[string]string{}
+			for _, label := range metric.Label {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["source"] == "blocking" {
+				if labels["result"] != "timeout" || metric.Summary.GetSampleCount() != 1 {
+					t.Fatalf("Expected a single timeout of the source, Got: %v", metric)
+				}
+				return
+			}
+		}
+	}
+	t.Fatal("Retrieved no scrape duration of the source")
+}
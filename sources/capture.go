@@ -0,0 +1,178 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// CaptureFile is a file of a capture of the node, named by its path within the archive.
+// The archive has the layout of the test fixtures: 'proc/', 'sys/' with debugfs in 'sys/kernel/debug/' and 'lctl/'.
+type CaptureFile struct {
+	Name    string
+	Content []byte
+}
+
+// Capturer is implemented by the sources which can capture the files and lctl output read by their enabled metric templates.
+type Capturer interface {
+	Capture(ctx context.Context) ([]CaptureFile, error)
+}
+
+// CaptureNode captures the input of the sources together with the Lustre version and the mount table.
+// Files which cannot be read are skipped and returned as error together with the captured files.
+func CaptureNode(ctx context.Context, paths Paths, sourceList []LustreSource) ([]CaptureFile, error) {
+	var errs collectionErrors
+	captured := map[string][]byte{}
+	add := func(files []CaptureFile, err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
+		for _, file := range files {
+			captured[file.Name] = file.Content
+		}
+	}
+
+	add(captureFiles(ctx, paths, versionPaths(paths), true))
+	if content, err := ioutil.ReadFile(filepath.Clean(paths.mountInfo())); err == nil {
+		captured["proc/self/mountinfo"] = content
+	}
+	for _, source := range sourceList {
+		if capturer, ok := source.(Capturer); ok {
+			add(capturer.Capture(ctx))
+		}
+	}
+
+	files := make([]CaptureFile, 0, len(captured))
+	for name, content := range captured {
+		files = append(files, CaptureFile{Name: name, Content: content})
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, errs.err()
+}
+
+// captureName returns the name of the file within the capture archive.
+func captureName(paths Paths, path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	for _, root := range []struct {
+		location string
+		name     string
+	}{
+		{paths.Proc, "proc"},
+		{paths.Debug, "sys/kernel/debug"},
+		{paths.Sys, "sys"},
+	} {
+		absRoot, err := filepath.Abs(root.location)
+		if err != nil {
+			return "", err
+		}
+		rel, err := filepath.Rel(absRoot, absPath)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(filepath.Join(root.name, rel)), nil
+		}
+	}
+	return "", fmt.Errorf("%s is neither within %s, %s nor %s", path, paths.Proc, paths.Sys, paths.Debug)
+}
+
+// captureFiles reads the files at the given paths, missing files are skipped if optional.
+func captureFiles(ctx context.Context, roots Paths, paths []string, optional bool) ([]CaptureFile, error) {
+	var errs collectionErrors
+	var files []CaptureFile
+	for _, path := range paths {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		name, err := captureName(roots, path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			if !optional {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		files = append(files, CaptureFile{Name: name, Content: content})
+	}
+	return files, errs.err()
+}
+
+// captureTemplates captures the files of the selected targets matched by the metric templates
+// within the first base path containing any match.
+func captureTemplates(ctx context.Context, config Config, metrics []lustreProcMetric, basePaths []string) ([]CaptureFile, error) {
+	var errs collectionErrors
+	var paths []string
+	for _, metric := range metrics {
+		matches, err := globPaths(basePaths, filepath.Join(metric.path, metric.filename))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		paths = append(paths, config.TargetFilter.filterPaths(matches, strings.Count(metric.filename, "/"))...)
+	}
+	files, err := captureFiles(ctx, config.Paths, paths, false)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	return files, errs.err()
+}
+
+// Capture captures the files read by the enabled metric templates.
+// The command names of the per-process client statistics are not captured.
+func (s *lustreProcFsSource) Capture(ctx context.Context) ([]CaptureFile, error) {
+	_, layout := s.layouts.detect(s.config.Paths)
+	return captureTemplates(ctx, s.config, s.lustreProcMetrics, layout.procfs)
+}
+
+// Capture captures the files read by the enabled metric templates.
+func (s *LustreSysFsSource) Capture(ctx context.Context) ([]CaptureFile, error) {
+	_, layout := s.layouts.detect(s.config.Paths)
+	return captureTemplates(ctx, s.config, s.lustreProcMetrics, layout.sysfs)
+}
+
+// Capture captures the files read by the enabled metric templates.
+func (s *lustreSysSource) Capture(ctx context.Context) ([]CaptureFile, error) {
+	_, layout := s.layouts.detect(s.config.Paths)
+	var paths []string
+	for _, metric := range s.lustreProcMetrics {
+		paths = append(paths, findPath(layout.lnet, filepath.Join(metric.path, metric.filename)))
+	}
+	return captureFiles(ctx, s.config.Paths, paths, false)
+}
+
+// Capture captures the output of the lctl calls of the enabled metric creators,
+// named like the recorded output read from the Lctl path.
+func (s *lustreLctlSource) Capture(ctx context.Context) ([]CaptureFile, error) {
+	var errs collectionErrors
+	var files []CaptureFile
+	for _, metricCreator := range s.metricCreator {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		content, err := s.getParam(ctx, nil, metricCreator.lctlParam)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s - %s", metricCreator.lctlParam, err))
+			continue
+		}
+		name := filepath.ToSlash(filepath.Join("lctl", strings.ReplaceAll(metricCreator.lctlParam, ".", OSPathSeparator)))
+		files = append(files, CaptureFile{Name: name, Content: content})
+	}
+	return files, errs.err()
+}
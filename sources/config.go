@@ -0,0 +1,76 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+// Config contains the settings passed to the constructors of the sources, see Factories.
+// Sources created with different configs are independent of each other, e.g.
+//
+//	config := sources.DefaultConfig()
+//	config.Ost, config.Client = "core", "disabled"
+//	source := sources.Factories["procfs"](config)
+//
+// The level of a collector is one of "extended", "core" or "disabled".
+type Config struct {
+	// Paths are the roots of the filesystems the sources read from
+	Paths Paths
+
+	// Ost is the level of the OST metrics
+	Ost string
+	// Mdt is the level of the MDT metrics
+	Mdt string
+	// Mgs is the level of the MGS metrics
+	Mgs string
+	// Mds is the level of the MDS metrics
+	Mds string
+	// Client is the level of the client metrics
+	Client string
+	// Generic is the level of the generic metrics
+	Generic string
+	// Lnet is the level of the LNET metrics
+	Lnet string
+	// Health is the level of the health status metrics
+	Health string
+
+	// ClientPerProcess enables the per-process client extent statistics
+	ClientPerProcess bool
+	// SnapshotTimestamps exports the samples of the stats, job_stats, brw_stats and rpc_stats files
+	// with the snapshot_time of the file or job as their timestamp.
+	SnapshotTimestamps bool
+
+	// MetricRules are applied in order to the metric families of the enabled collectors, the last matching rule wins.
+	// Metric families not matched by any rule are selected by the level of their collector.
+	MetricRules []MetricRule
+	// TargetFilter selects the targets whose files are read by the sources
+	TargetFilter TargetFilter
+
+	// CommandRunner runs the lctl commands. If nil, the recorded lctl output within Paths.Lctl is read instead.
+	CommandRunner CommandRunner
+	// Telemetry records the self-metrics of the sources created with the config. If nil, no self-metrics are recorded.
+	Telemetry *Telemetry
+
+	// recordFamily is called with each metric family considered by the constructors, see knownMetricFamilies
+	recordFamily func(collector string, filePattern string, promName string)
+}
+
+// DefaultConfig returns the config collecting all metrics of the local node.
+func DefaultConfig() Config {
+	return Config{
+		Paths:         DefaultPaths(),
+		Ost:           extended,
+		Mdt:           extended,
+		Mgs:           extended,
+		Mds:           extended,
+		Client:        extended,
+		Generic:       extended,
+		Lnet:          extended,
+		Health:        extended,
+		CommandRunner: ExecCommandRunner{},
+		Telemetry:     NewTelemetry(),
+	}
+}
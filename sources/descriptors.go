@@ -0,0 +1,119 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// targetLabelSets returns all label names targetLabels may return, the identifying labels followed by
+// any combination of the labels of the target name elements in their order.
+func targetLabelSets() [][]string {
+	labelSets := [][]string{{"component", "target"}}
+	for _, label := range targetNameLabels {
+		for _, labelSet := range labelSets {
+			labelSets = append(labelSets, append(append([]string{}, labelSet...), label.name))
+		}
+	}
+	return labelSets
+}
+
+// withTargetLabels returns the label sets of the targets, each followed by the given labels.
+func withTargetLabels(labels ...string) [][]string {
+	var labelSets [][]string
+	for _, labelSet := range targetLabelSets() {
+		labelSets = append(labelSets, append(labelSet, labels...))
+	}
+	return labelSets
+}
+
+// labelSetKey returns the key of the descriptor of a metric with the label names.
+func labelSetKey(labels []string) string {
+	return strings.Join(labels, ",")
+}
+
+// metricDescriptor contains the descriptors of a metric, one for each set of label names its samples are created with.
+type metricDescriptor struct {
+	help string
+	// labels are all label names the samples may have
+	labels []string
+	descs  map[string]*prometheus.Desc
+}
+
+// descriptorList contains the descriptors of all metrics a source creates by their fully-qualified name.
+// They are created with the metric templates of the source and reused for every sample.
+type descriptorList struct {
+	// described contains a descriptor with all label names of each metric, which are sent on Describe.
+	// The registry identifies descriptors by their name and constant labels, so this one descriptor
+	// covers the samples of all label sets, while the registration still checks its help and label names.
+	described []*prometheus.Desc
+	byName    map[string]*metricDescriptor
+	// telemetry records the metrics that are sanitized or cannot be created, it may be nil
+	telemetry *Telemetry
+}
+
+// addDescriptor adds the descriptors of the metric for each of the given sets of label names.
+// A metric added again with a different help or label names is described a second time,
+// so registering the source with Prometheus fails with the conflict at startup.
+func (l *descriptorList) addDescriptor(name string, helpText string, labelSets ...[]string) {
+	fqName := prometheus.BuildFQName(Namespace, "", name)
+	var labels []string
+	for _, labelSet := range labelSets {
+		for _, label := range labelSet {
+			if indexOf(labels, label) < 0 {
+				labels = append(labels, label)
+			}
+		}
+	}
+	descriptor, exists := l.byName[fqName]
+	if exists && (descriptor.help != helpText || !reflect.DeepEqual(descriptor.labels, labels)) {
+		l.described = append(l.described, prometheus.NewDesc(fqName, helpText, labels, nil))
+		return
+	}
+	if !exists {
+		descriptor = &metricDescriptor{help: helpText, labels: labels, descs: map[string]*prometheus.Desc{}}
+		if l.byName == nil {
+			l.byName = map[string]*metricDescriptor{}
+		}
+		l.byName[fqName] = descriptor
+		l.described = append(l.described, prometheus.NewDesc(fqName, helpText, labels, nil))
+	}
+	for _, labelSet := range labelSets {
+		if key := labelSetKey(labelSet); descriptor.descs[key] == nil {
+			descriptor.descs[key] = prometheus.NewDesc(fqName, helpText, labelSet, nil)
+		}
+	}
+}
+
+// desc returns the descriptor added for the metric with the label names.
+func (l *descriptorList) desc(fqName string, helpText string, labels []string) (*prometheus.Desc, error) {
+	descriptor, exists := l.byName[fqName]
+	if !exists {
+		return nil, fmt.Errorf("no descriptor has been added for the metric")
+	}
+	if descriptor.help != helpText {
+		return nil, fmt.Errorf("help %q differs from the descriptor help %q", helpText, descriptor.help)
+	}
+	desc, exists := descriptor.descs[labelSetKey(labels)]
+	if !exists {
+		return nil, fmt.Errorf("no descriptor has been added for the labels %v", labels)
+	}
+	return desc, nil
+}
+
+// Describe sends the descriptors of all metrics the source creates.
+func (l *descriptorList) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range l.described {
+		ch <- desc
+	}
+}
@@ -0,0 +1,113 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"fmt"
+	"regexp"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// TargetFilter selects the targets to collect by their filesystem name and target name.
+// A nil expression does not filter any target.
+type TargetFilter struct {
+	FsnameInclude *regexp.Regexp
+	FsnameExclude *regexp.Regexp
+	TargetInclude *regexp.Regexp
+	TargetExclude *regexp.Regexp
+}
+
+// NewTargetFilter compiles the include and exclude expressions, an empty expression does not filter any target.
+// The expressions are anchored and have to match the whole name.
+func NewTargetFilter(fsnameInclude string, fsnameExclude string, targetInclude string, targetExclude string) (TargetFilter, error) {
+	var f TargetFilter
+	var err error
+	for _, filter := range []struct {
+		name       string
+		expression string
+		regexp     **regexp.Regexp
+	}{
+		{"fsname include", fsnameInclude, &f.FsnameInclude},
+		{"fsname exclude", fsnameExclude, &f.FsnameExclude},
+		{"target include", targetInclude, &f.TargetInclude},
+		{"target exclude", targetExclude, &f.TargetExclude},
+	} {
+		if filter.expression == "" {
+			continue
+		}
+		*filter.regexp, err = regexp.Compile("^(?:" + filter.expression + ")$")
+		if err != nil {
+			return TargetFilter{}, fmt.Errorf("invalid %s filter %q: %s", filter.name, filter.expression, err)
+		}
+	}
+	return f, nil
+}
+
+// targetSelected returns whether the target or client instance with the given name is collected.
+// Names not following the Lustre naming scheme are always collected.
+// The filesystem filters do not apply to the MGS, which serves all filesystems, and the target filters
+// do not apply to client instances like 'lustrefs-ffff88105db50000', which are selected by their filesystem.
+// Connections to a target like 'lustrefs-OST0000-osc-MDT0000' are selected by the name of the target they connect to.
+func (f TargetFilter) targetSelected(name string) bool {
+	target, ok := parseTargetName(name)
+	if !ok {
+		return true
+	}
+	if target.fsname != "" {
+		if f.FsnameInclude != nil && !f.FsnameInclude.MatchString(target.fsname) {
+			return false
+		}
+		if f.FsnameExclude != nil && f.FsnameExclude.MatchString(target.fsname) {
+			return false
+		}
+	}
+	if target.targetType == "" {
+		return true
+	}
+	if match := targetRegexPattern.FindStringSubmatch(name); match != nil {
+		name = match[1] + "-" + match[2] + match[3]
+	}
+	if f.TargetInclude != nil && !f.TargetInclude.MatchString(name) {
+		return false
+	}
+	if f.TargetExclude != nil && f.TargetExclude.MatchString(name) {
+		return false
+	}
+	return true
+}
+
+// filterPaths returns the paths without the ones belonging to a target that is not selected,
+// so the files of filtered targets are never read.
+// The directory depth is the number of directories between the target directory and the files.
+func (f TargetFilter) filterPaths(paths []string, directoryDepth int) []string {
+	if f == (TargetFilter{}) {
+		return paths
+	}
+	var selected []string
+	for _, path := range paths {
+		if f.pathSelected(path, directoryDepth) {
+			selected = append(selected, path)
+		} else {
+			log.Debugf("Skipping filtered target file: %s", path)
+		}
+	}
+	return selected
+}
+
+// pathSelected returns whether the target the file of the path belongs to is selected.
+// Only the directory the target name of the labels is parsed from is checked,
+// other elements like the base path or files named after a filesystem never match the filters.
+func (f TargetFilter) pathSelected(path string, directoryDepth int) bool {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return true
+	}
+	return f.targetSelected(nodeName)
+}
@@ -0,0 +1,96 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTargetFilter(t *testing.T) {
+	f, err := NewTargetFilter("lustrefs|scratch", "", "", "lustrefs-OST0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]bool{
+		"lustrefs-OST0000":                  true,
+		"lustrefs-OST0001":                  false,
+		"lustrefs-OST0001-osc-MDT0000":      false,
+		"lustrefs-OST0000-osc-ffff88105db5": true,
+		"scratch-MDT0000":                   true,
+		"otherfs-MDT0000":                   false,
+		"otherfs-ffff88105db50000":          false,
+		"lustrefsx-OST0000":                 false,
+		"MGS":                               true,
+		"obdfilter":                         true,
+	}
+	for name, expected := range tests {
+		if selected := f.targetSelected(name); selected != expected {
+			t.Fatalf("Unexpected selection of %s. Expected: %t, Got: %t", name, expected, selected)
+		}
+	}
+
+	paths := []string{
+		"srv/lustrefs-OST0001/proc/fs/lustre/obdfilter/lustrefs-OST0000/job_stats",
+		"proc/fs/lustre/obdfilter/lustrefs-OST0000/job_stats",
+		"proc/fs/lustre/obdfilter/lustrefs-OST0001/job_stats",
+		"proc/fs/lustre/obdfilter/otherfs-OST0000/job_stats",
+		"sys/fs/lustre/ldlm/namespaces/filter-lustrefs-OST0001_UUID/lock_count",
+		"proc/fs/lustre/health_check",
+	}
+	expected := []string{
+		"srv/lustrefs-OST0001/proc/fs/lustre/obdfilter/lustrefs-OST0000/job_stats",
+		"proc/fs/lustre/obdfilter/lustrefs-OST0000/job_stats",
+		"proc/fs/lustre/health_check",
+	}
+	if selected := f.filterPaths(paths, 0); !reflect.DeepEqual(selected, expected) {
+		t.Fatalf("Retrieved unexpected paths. Expected: %v, Got: %v", expected, selected)
+	}
+	if selected := (TargetFilter{}).filterPaths(paths, 0); !reflect.DeepEqual(selected, paths) {
+		t.Fatalf("Expected no paths to be filtered without filters, Got: %v", selected)
+	}
+	paths = []string{
+		"sys/fs/lustre/ldlm/namespaces/filter-lustrefs-OST0000_UUID/pool/granted",
+		"sys/fs/lustre/ldlm/namespaces/filter-lustrefs-OST0001_UUID/pool/granted",
+	}
+	if selected := f.filterPaths(paths, 1); !reflect.DeepEqual(selected, paths[:1]) {
+		t.Fatalf("Retrieved unexpected paths. Expected: %v, Got: %v", paths[:1], selected)
+	}
+
+	// The target filters do not apply to client instances and the filesystem filters not to the MGS
+	f, err = NewTargetFilter("lustrefs", "", "lustrefs-OST000[0-3]", "MGS")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests = map[string]bool{
+		"lustrefs-ffff88105db50000":             true,
+		"lustrefs-clilmv-ffff88105db50000":      true,
+		"otherfs-ffff88105db50000":              false,
+		"lustrefs-OST0000-osc-ffff88105db50000": true,
+		"lustrefs-OST0004-osc-ffff88105db50000": false,
+		"lustrefs-MDT0000-mdc-ffff88105db50000": false,
+		"MGS":                                   false,
+	}
+	for name, expected := range tests {
+		if selected := f.targetSelected(name); selected != expected {
+			t.Fatalf("Unexpected selection of %s. Expected: %t, Got: %t", name, expected, selected)
+		}
+	}
+	f, err = NewTargetFilter("otherfs", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.targetSelected("MGS") {
+		t.Fatal("Expected the MGS to be selected by any filesystem filter")
+	}
+
+	if _, err := NewTargetFilter("", "", "lustrefs-(OST", ""); err == nil {
+		t.Fatal("Expected an error for an invalid expression")
+	}
+}
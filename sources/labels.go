@@ -0,0 +1,194 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	// Targets are named after the filesystem, the target type and the hexadecimal target index, e.g. 'lustrefs-OST0004'.
+	// Connections to a target are suffixed with the device type and the peer, which is either another target as in
+	// 'lustrefs-MDT0000-lwp-OST0002' and 'lustrefs-OST0000-osc-MDT0000' or the superblock address of a client mount
+	// as in 'lustrefs-OST0000-osc-ffff88105db50000'.
+	targetRegexPattern = regexp.MustCompile(`^(\w+)-(OST|MDT|QMT)([0-9a-fA-F]{4,})(?:-(?:osc|osp|lwp|mdc|qsd)-(.+))?$`)
+	// Client instances without a target are named after the filesystem and the superblock address of the mount,
+	// e.g. 'lustrefs-ffff88105db50000' or 'lustrefs-clilmv-ffff88105db50000'.
+	clientInstanceRegexPattern = regexp.MustCompile(`^(\w+)-(?:\w+-)?[0-9a-f]{16}$`)
+	peerTargetRegexPattern     = regexp.MustCompile(`^(?:OST|MDT)[0-9a-fA-F]{4,}$`)
+	mountEscapeRegexPattern    = regexp.MustCompile(`\\[0-7]{3}`)
+)
+
+// lustreTargetName contains the elements of a parsed target name.
+type lustreTargetName struct {
+	fsname      string
+	targetType  string
+	targetIndex string
+	peerTarget  string
+	client      bool // client is true if the target name refers to a client mount
+}
+
+// parseTargetName splits the name of a target into its elements.
+// It returns false if the name does not follow the Lustre naming scheme, e.g. for 'lnet' or 'sptlrpc'.
+func parseTargetName(name string) (target lustreTargetName, ok bool) {
+	if name == "MGS" {
+		return lustreTargetName{targetType: "MGS"}, true
+	}
+	if match := targetRegexPattern.FindStringSubmatch(name); match != nil {
+		index, err := strconv.ParseUint(match[3], 16, 64)
+		if err != nil {
+			return target, false
+		}
+		target = lustreTargetName{
+			fsname:      match[1],
+			targetType:  match[2],
+			targetIndex: strconv.FormatUint(index, 10),
+		}
+		if peerTargetRegexPattern.MatchString(match[4]) {
+			target.peerTarget = match[1] + "-" + match[4]
+		} else if match[4] != "" {
+			target.client = true
+		}
+		return target, true
+	}
+	if match := clientInstanceRegexPattern.FindStringSubmatch(name); match != nil {
+		return lustreTargetName{fsname: match[1], client: true}, true
+	}
+	return target, false
+}
+
+// lustreMounts maps the filesystem names of mounted Lustre clients to their mount points.
+type lustreMounts map[string]string
+
+// loadLustreMounts reads the Lustre client mounts from the mount table.
+// A missing mount table is not an error, the mount point labels are omitted instead.
+// The client instances of a filesystem mounted multiple times cannot be told apart by the mount table,
+// so the mount point labels of such a filesystem are omitted as well.
+func loadLustreMounts(path string) lustreMounts {
+	mounts := lustreMounts{}
+	file, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		log.Debugf("Unable to read mount table: %s", err)
+		return mounts
+	}
+	defer file.Close()
+
+	ambiguous := map[string]bool{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fsname, mountPoint, ok := parseMountLine(scanner.Text())
+		if !ok || ambiguous[fsname] {
+			continue
+		}
+		if existing, exists := mounts[fsname]; exists {
+			log.Debugf("Filesystem %q is mounted at %q and %q, omitting its mount point labels", fsname, existing, mountPoint)
+			delete(mounts, fsname)
+			ambiguous[fsname] = true
+			continue
+		}
+		mounts[fsname] = mountPoint
+	}
+	if err := scanner.Err(); err != nil {
+		log.Debugf("Unable to read mount table: %s", err)
+	}
+	return mounts
+}
+
+func parseMountLine(line string) (fsname string, mountPoint string, ok bool) {
+	fields := strings.Fields(line)
+	var fsType, device string
+	if separator := indexOf(fields, "-"); separator >= 5 && len(fields) > separator+2 {
+		// mountinfo: [id] [parent] [major:minor] [root] [mount point] [options] ... - [type] [device] [super options]
+		mountPoint, fsType, device = fields[4], fields[separator+1], fields[separator+2]
+	} else if len(fields) >= 3 {
+		// mounts: [device] [mount point] [type] [options] [dump] [pass]
+		device, mountPoint, fsType = fields[0], fields[1], fields[2]
+	} else {
+		return "", "", false
+	}
+	if fsType != "lustre" {
+		return "", "", false
+	}
+	// The device is given as '<MGS NIDs>:/<fsname>'
+	index := strings.LastIndex(device, ":/")
+	if index < 0 {
+		return "", "", false
+	}
+	return device[index+2:], unescapeMountPoint(mountPoint), true
+}
+
+// unescapeMountPoint replaces the octal escapes the kernel uses for whitespace within mount points.
+func unescapeMountPoint(mountPoint string) string {
+	return mountEscapeRegexPattern.ReplaceAllStringFunc(mountPoint, func(escape string) string {
+		value, err := strconv.ParseUint(escape[1:], 8, 8)
+		if err != nil {
+			return escape
+		}
+		return string(rune(value))
+	})
+}
+
+func indexOf(fields []string, value string) int {
+	for i, field := range fields {
+		if field == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// targetNameLabels are the labels of the elements of a target name in the order they are added.
+// Each one is omitted if it does not apply to a target, e.g. the target type of a client instance.
+var targetNameLabels = []struct {
+	name  string
+	value func(target lustreTargetName, mounts lustreMounts) (string, bool)
+}{
+	{"fsname", func(target lustreTargetName, mounts lustreMounts) (string, bool) {
+		return target.fsname, target.fsname != ""
+	}},
+	{"target_type", func(target lustreTargetName, mounts lustreMounts) (string, bool) {
+		return target.targetType, target.targetType != ""
+	}},
+	{"target_index", func(target lustreTargetName, mounts lustreMounts) (string, bool) {
+		return target.targetIndex, target.targetIndex != ""
+	}},
+	{"peer_target", func(target lustreTargetName, mounts lustreMounts) (string, bool) {
+		return target.peerTarget, target.peerTarget != ""
+	}},
+	{"mountpoint", func(target lustreTargetName, mounts lustreMounts) (string, bool) {
+		mountPoint, exists := mounts[target.fsname]
+		return mountPoint, target.client && exists
+	}},
+}
+
+// targetLabels returns the label names and values identifying the given target.
+// Targets following the Lustre naming scheme are additionally labelled with the elements of their name.
+// Client mounts are labelled with their mount point, which in contrast to the target name is stable across remounts.
+// Labels not applying to the target, like the mount point of a client not found in the mount table, are omitted.
+func targetLabels(nodeType string, nodeName string, mounts lustreMounts) ([]string, []string) {
+	labels := []string{"component", "target"}
+	labelValues := []string{nodeType, nodeName}
+	target, ok := parseTargetName(nodeName)
+	if !ok {
+		return labels, labelValues
+	}
+	for _, label := range targetNameLabels {
+		if value, applies := label.value(target, mounts); applies {
+			labels, labelValues = append(labels, label.name), append(labelValues, value)
+		}
+	}
+	return labels, labelValues
+}
@@ -0,0 +1,121 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseMountLine(t *testing.T) {
+	tests := map[string][]string{
+		"41 22 0:38 / /lustre/fs rw,relatime shared:23 - lustre 10.20.0.1@o2ib:10.20.0.2@o2ib:/lustrefs rw,flock": {"lustrefs", "/lustre/fs"},
+		"41 22 0:38 / /mnt/my\\040fs rw,relatime - lustre 10.20.0.1@tcp:/scratch rw":                              {"scratch", "/mnt/my fs"},
+		"10.20.0.1@o2ib:/home /lustre/home lustre rw,flock 0 0":                                                   {"home", "/lustre/home"},
+	}
+	for line, expected := range tests {
+		fsname, mountPoint, ok := parseMountLine(line)
+		if !ok {
+			t.Fatalf("No Lustre mount found in line: %s", line)
+		}
+		if fsname != expected[0] || mountPoint != expected[1] {
+			t.Fatalf("Retrieved an unexpected mount. Expected: %v, Got: [%s %s]", expected, fsname, mountPoint)
+		}
+	}
+
+	for _, line := range []string{
+		"22 1 253:0 / / rw,relatime shared:1 - xfs /dev/mapper/root rw,attr2",
+		"/dev/sda1 /boot ext4 rw 0 0",
+		"",
+	} {
+		if _, _, ok := parseMountLine(line); ok {
+			t.Fatalf("Unexpected Lustre mount found in line: %s", line)
+		}
+	}
+}
+
+func TestLoadLustreMounts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	mountInfo := `22 1 253:0 / / rw,relatime shared:1 - xfs /dev/mapper/root rw,attr2,inode64,noquota
+41 22 0:38 / /lustre/fs rw,relatime shared:23 - lustre 10.20.0.1@o2ib:10.20.0.2@o2ib:/lustrefs rw,flock,lazystatfs
+42 22 0:39 / /lustre/scratch rw,relatime shared:24 - lustre 10.20.0.1@o2ib:/scratch rw,flock,lazystatfs
+43 22 0:40 / /lustre/fs2 rw,relatime shared:25 - lustre 10.20.0.1@o2ib:10.20.0.2@o2ib:/lustrefs rw,flock,lazystatfs
+44 22 0:41 / /lustre/fs3 rw,relatime shared:26 - lustre 10.20.0.1@o2ib:10.20.0.2@o2ib:/lustrefs rw,flock,lazystatfs
+`
+	path := filepath.Join(dir, "mountinfo")
+	if err := ioutil.WriteFile(path, []byte(mountInfo), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// The client instances of a filesystem mounted multiple times cannot be assigned to a mount point
+	expected := lustreMounts{"scratch": "/lustre/scratch"}
+	if mounts := loadLustreMounts(path); !reflect.DeepEqual(mounts, expected) {
+		t.Fatalf("Retrieved unexpected mounts. Expected: %v, Got: %v", expected, mounts)
+	}
+	if mounts := loadLustreMounts(filepath.Join(dir, "missing")); len(mounts) != 0 {
+		t.Fatalf("Retrieved unexpected mounts from a missing mount table: %v", mounts)
+	}
+}
+
+func TestParseTargetName(t *testing.T) {
+	tests := map[string]lustreTargetName{
+		"lustrefs-OST0004":                      {fsname: "lustrefs", targetType: "OST", targetIndex: "4"},
+		"lustrefs-OST001a":                      {fsname: "lustrefs", targetType: "OST", targetIndex: "26"},
+		"lustrefs-MDT0000":                      {fsname: "lustrefs", targetType: "MDT", targetIndex: "0"},
+		"lustrefs-QMT0000":                      {fsname: "lustrefs", targetType: "QMT", targetIndex: "0"},
+		"MGS":                                   {targetType: "MGS"},
+		"lustrefs-MDT0000-lwp-OST0002":          {fsname: "lustrefs", targetType: "MDT", targetIndex: "0", peerTarget: "lustrefs-OST0002"},
+		"lustrefs-OST0003-osc-MDT0000":          {fsname: "lustrefs", targetType: "OST", targetIndex: "3", peerTarget: "lustrefs-MDT0000"},
+		"lustrefs-MDT0001-osp-MDT0000":          {fsname: "lustrefs", targetType: "MDT", targetIndex: "1", peerTarget: "lustrefs-MDT0000"},
+		"lustrefs-OST0000-osc-ffff88105db50000": {fsname: "lustrefs", targetType: "OST", targetIndex: "0", client: true},
+		"lustrefs-MDT0000-mdc-ffff88105db50000": {fsname: "lustrefs", targetType: "MDT", targetIndex: "0", client: true},
+		"lustrefs-ffff88105db50000":             {fsname: "lustrefs", client: true},
+		"lustrefs-clilmv-ffff88105db50000":      {fsname: "lustrefs", client: true},
+	}
+	for name, expected := range tests {
+		target, ok := parseTargetName(name)
+		if !ok {
+			t.Fatalf("Unable to parse target name: %s", name)
+		}
+		if target != expected {
+			t.Fatalf("Retrieved an unexpected target for %s. Expected: %+v, Got: %+v", name, expected, target)
+		}
+	}
+
+	for _, name := range []string{"lnet", "sptlrpc", "osd", "lustre", "lustrefs-OSTXYZ0"} {
+		if target, ok := parseTargetName(name); ok {
+			t.Fatalf("Unexpected target parsed from %s: %+v", name, target)
+		}
+	}
+}
+
+func TestTargetLabels(t *testing.T) {
+	mounts := lustreMounts{"lustrefs": "/lustre/fs"}
+
+	tests := map[string][]string{
+		"lustrefs-ffff88105db50000":             {"client", "lustrefs-ffff88105db50000", "lustrefs", "/lustre/fs"},
+		"lustrefs-OST0000-osc-ffff88105db50000": {"client", "lustrefs-OST0000-osc-ffff88105db50000", "lustrefs", "OST", "0", "/lustre/fs"},
+		"scratch-MDT0000-mdc-ffff88105db50000":  {"client", "scratch-MDT0000-mdc-ffff88105db50000", "scratch", "MDT", "0"},
+		"lustrefs-MDT0000-lwp-OST0002":          {"client", "lustrefs-MDT0000-lwp-OST0002", "lustrefs", "MDT", "0", "lustrefs-OST0002"},
+		"sptlrpc":                               {"client", "sptlrpc"},
+	}
+	for target, expected := range tests {
+		_, labelValues := targetLabels("client", target, mounts)
+		if !reflect.DeepEqual(labelValues, expected) {
+			t.Fatalf("Retrieved unexpected label values. Expected: %v, Got: %v", expected, labelValues)
+		}
+	}
+}
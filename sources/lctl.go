@@ -9,8 +9,9 @@
 package sources
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"os/exec"
 	"path/filepath"
 	"reflect"
@@ -18,6 +19,8 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
@@ -32,11 +35,19 @@ var (
 
 const (
 	lctlParamChangelogUsers = "mdd.*-*.changelog_users"
+
+	// lctlKillDelay is the time a timed out command is given to terminate before it is killed
+	lctlKillDelay = time.Second
+
+	changelogCurrentIndexHelp = "Changelog current index."
+	changelogUserIndexHelp    = "Index of registered changelog user."
+	changelogUserIdleTimeHelp = "Idle time in seconds of registered changelog user."
 )
 
 type lustreLctlMetricCreator struct {
+	source        string
 	lctlParam     string
-	metricHandler func(string) ([]prometheus.Metric, error)
+	metricHandler func(context.Context, *fileTelemetry, string) ([]prometheus.Metric, error)
 }
 
 func init() {
@@ -73,94 +84,141 @@ func regexCaptureChangelogUser(textToMatch string) [][]string {
 }
 
 type lustreLctlSource struct {
+	descriptorList
 	metricCreator []lustreLctlMetricCreator
+	config        Config
 }
 
-func newLustreLctlSource() LustreSource {
-	if LctlCommandMode {
-		_, err := exec.LookPath("lctl")
-		if err != nil {
-			log.Error(err)
-			return nil
-		}
-		_, err = exec.LookPath("sudo")
-		if err != nil {
-			log.Error(err)
-			return nil
+func newLustreLctlSource(config Config) LustreSource {
+	if config.CommandRunner != nil {
+		for _, name := range []string{"lctl", "sudo"} {
+			if err := config.CommandRunner.LookPath(name); err != nil {
+				log.Error(err)
+				return nil
+			}
 		}
 	}
 	var l lustreLctlSource
+	l.config = config
+	l.telemetry = config.Telemetry
 	l.metricCreator = []lustreLctlMetricCreator{}
-	l.generateMDTMetricCreator(MdtEnabled)
+	if config.Mdt != disabled {
+		l.generateMDTMetricCreator(config.Mdt)
+	}
 	return &l
 }
 
-func (s *lustreLctlSource) Update(ch chan<- prometheus.Metric) (err error) {
+func (s *lustreLctlSource) Update(ctx context.Context, ch chan<- prometheus.Metric) (err error) {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+// Collectors returns the names of the collectors the source creates metrics for.
+func (s *lustreLctlSource) Collectors() []string {
+	var collectors []string
 	for _, metricCreator := range s.metricCreator {
-		metricList, err := metricCreator.metricHandler(metricCreator.lctlParam)
+		collectors = appendCollector(collectors, metricCreator.source)
+	}
+	return collectors
+}
+
+func (s *lustreLctlSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection Selection) (err error) {
+	var errs collectionErrors
+	for _, metricCreator := range s.metricCreator {
+		if !selection.selected(metricCreator.source) {
+			continue
+		}
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+		telemetry := s.config.Telemetry.newFileTelemetry(metricCreator.source, metricCreator.lctlParam)
+		metricList, err := metricCreator.metricHandler(ctx, telemetry, metricCreator.lctlParam)
 		if err != nil {
-			return fmt.Errorf("%s - %s", runtime.FuncForPC(reflect.ValueOf(metricCreator.metricHandler).Pointer()).Name(), err)
+			telemetry.failed()
+			errs = append(errs, fmt.Errorf("%s - %s", runtime.FuncForPC(reflect.ValueOf(metricCreator.metricHandler).Pointer()).Name(), err))
 		}
 		for _, metric := range metricList {
-			ch <- metric
+			sendMetric(ch, metric)
 		}
+		telemetry.done()
 	}
-	return nil
+	return errs.err()
 }
 
 func (s *lustreLctlSource) generateMDTMetricCreator(filter string) {
-	if filter == extended {
+	// The changelog metrics are created from a single lctl call and therefore selected together
+	selected := false
+	for _, promName := range []string{"changelog_current_index", "changelog_user_index", "changelog_user_idle_time"} {
+		if s.config.metricSelected(filter, "mdt", "changelog_users", promName, extended) {
+			selected = true
+		}
+	}
+	if selected {
 		s.metricCreator = append(s.metricCreator,
 			lustreLctlMetricCreator{
+				source:        "mdt",
 				lctlParam:     lctlParamChangelogUsers,
 				metricHandler: s.createMDTChangelogUsersMetrics})
+		s.addDescriptor("changelog_current_index", changelogCurrentIndexHelp, withTargetLabels()...)
+		s.addDescriptor("changelog_user_index", changelogUserIndexHelp, withTargetLabels("id")...)
+		s.addDescriptor("changelog_user_idle_time", changelogUserIdleTimeHelp, withTargetLabels("id")...)
 	}
 }
 
-func (s *lustreLctlSource) createMDTChangelogUsersMetrics(lctlParam string) ([]prometheus.Metric, error) {
+// getParam returns the output of 'lctl get_param' for the parameter,
+// which is read from the recorded output within the Lctl path if the config has no CommandRunner.
+// The output is recorded to the telemetry of the scrape, which may be nil.
+func (s *lustreLctlSource) getParam(ctx context.Context, telemetry *fileTelemetry, lctlParam string) ([]byte, error) {
+	if s.config.CommandRunner == nil {
+		paramPath := strings.ReplaceAll(lctlParam, ".", OSPathSeparator)
+		return telemetry.readFile(filepath.Join(s.config.Paths.Lctl, paramPath))
+	}
+	lctlCmdArgs := append(lctlGetParamArgs, lctlParam)
+	if log.GetLevel() == log.DebugLevel {
+		log.Debugf("Executing command: %s", "sudo "+strings.Join(lctlCmdArgs, " "))
+	}
+	out, err := s.config.CommandRunner.Run(ctx, "sudo", lctlCmdArgs...)
+	if err != nil {
+		return nil, err
+	}
+	telemetry.read(out)
+	return out, nil
+}
+
+func (s *lustreLctlSource) createMDTChangelogUsersMetrics(ctx context.Context, telemetry *fileTelemetry, lctlParam string) ([]prometheus.Metric, error) {
 	metricList := make([]prometheus.Metric, 1)
 	var target string
 	var data string
 	var err error
 
-	if LctlCommandMode {
-		lctlCmdArgs := append(lctlGetParamArgs, lctlParam)
-		if log.GetLevel() == log.DebugLevel {
-			log.Debugf("Executing command: %s", "sudo "+strings.Join(lctlCmdArgs, " "))
-		}
-		out, err := exec.Command("sudo", lctlCmdArgs...).Output()
-		if err != nil {
-			return nil, err
-		}
-		data = string(out)
-
-	} else {
-		paramPath := strings.ReplaceAll(lctlParam, ".", OSPathSeparator)
-
-		path := filepath.Join("lctl", paramPath)
-
-		out, err := ioutil.ReadFile(path)
-		if err != nil {
-			return nil, err
-		}
-		data = string(out)
+	out, err := s.getParam(ctx, telemetry, lctlParam)
+	if err != nil {
+		return nil, err
 	}
+	data = string(out)
 
 	target, err = regexCaptureChangelogTarget(data)
 	if err != nil {
 		return nil, err
 	}
+	// The changelogs of all targets are read by a single lctl call, so filtered targets are dropped afterwards
+	if !s.config.TargetFilter.targetSelected(target) {
+		log.Debugf("Skipping filtered target changelog: %s", target)
+		return nil, nil
+	}
 
 	currentIndex, err := regexCaptureChangelogCurrentIndex(data)
 	if err != nil {
 		return nil, err
 	}
 
-	metricList[0] = counterMetric(
-		[]string{"component", "target"},
-		[]string{"mdt", target},
+	labels, labelValues := targetLabels("mdt", target, nil)
+
+	metricList[0] = s.counterMetric(
+		labels,
+		labelValues,
 		"changelog_current_index",
-		"Changelog current index.",
+		changelogCurrentIndexHelp,
 		currentIndex)
 
 	// Captures registered changelog user:
@@ -178,22 +236,90 @@ func (s *lustreLctlSource) createMDTChangelogUsersMetrics(lctlParam string) ([]p
 			return nil, err
 		}
 
-		metric := counterMetric(
-			[]string{"component", "target", "id"},
-			[]string{"mdt", target, id},
+		userLabels, userLabelValues := targetLabels("mdt", target, nil)
+		userLabels, userLabelValues = append(userLabels, "id"), append(userLabelValues, id)
+
+		metric := s.counterMetric(
+			userLabels,
+			userLabelValues,
 			"changelog_user_index",
-			"Index of registered changelog user.",
+			changelogUserIndexHelp,
 			index)
 		metricList = append(metricList, metric)
 
-		metric = gaugeMetric(
-			[]string{"component", "target", "id"},
-			[]string{"mdt", target, id},
+		metric = s.gaugeMetric(
+			userLabels,
+			userLabelValues,
 			"changelog_user_idle_time",
-			"Idle time in seconds of registered changelog user.",
+			changelogUserIdleTimeHelp,
 			idleSeconds)
 		metricList = append(metricList, metric)
 	}
 
 	return metricList, nil
 }
+
+// CommandRunner runs the commands of the lctl source.
+type CommandRunner interface {
+	// LookPath returns an error if the command is not available.
+	LookPath(name string) error
+	// Run runs the command and returns its output, the command has to be stopped once the context is done.
+	Run(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+// ExecCommandRunner runs the commands on the local node, see runCommand.
+type ExecCommandRunner struct{}
+
+// LookPath returns an error if the command is not found within the PATH.
+func (ExecCommandRunner) LookPath(name string) error {
+	_, err := exec.LookPath(name)
+	return err
+}
+
+// Run runs the command and terminates it with its process group once the context is done.
+func (ExecCommandRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return runCommand(ctx, name, args...)
+}
+
+// runCommand runs the command in its own process group and returns its output.
+// If the context is done before the command exits, the command is terminated and killed with its process group
+// after lctlKillDelay, so neither sudo nor the lctl started by it outlive a timed out scrape.
+// sudo relays the termination signal to lctl, which cannot be killed directly unless the exporter runs as root.
+// A command which still does not exit, e.g. a root-owned lctl or one blocked in a hung Lustre call,
+// is abandoned after another lctlKillDelay, so the scrape returns anyway.
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		return stdout.Bytes(), nil
+	case <-ctx.Done():
+	}
+	log.Warnf("Terminating command %q after %s", name+" "+strings.Join(args, " "), ctx.Err())
+	_ = cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-done:
+	case <-time.After(lctlKillDelay):
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		select {
+		case <-done:
+		case <-time.After(lctlKillDelay):
+			// The wait finishes in the background once the command exits, if ever
+			log.Errorf("Abandoning command %q, which did not exit after being killed", name+" "+strings.Join(args, " "))
+		}
+	}
+	return nil, ctx.Err()
+}
@@ -9,7 +9,13 @@
 package sources
 
 import (
+	"context"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 func TestChangelogTarget(t *testing.T) {
@@ -104,3 +110,81 @@ func TestChangelogUser(t *testing.T) {
 		t.Fatalf("Retrieved an unexpected value. Expected: %s, Got: %s", expected, matched)
 	}
 }
+
+func TestRunCommandTimeout(t *testing.T) {
+	out, err := runCommand(context.Background(), "echo", "changelog_users")
+	if err != nil || string(out) != "changelog_users\n" {
+		t.Fatalf("Unexpected command output %q: %v", out, err)
+	}
+
+	// The shell ignores the termination, so the process group is killed after the delay
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	begin := time.Now()
+	_, err = runCommand(ctx, "sh", "-c", `trap "" TERM; sleep 10 & wait`)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the deadline to be exceeded, Got: %v", err)
+	}
+	if duration := time.Since(begin); duration > lctlKillDelay+5*time.Second {
+		t.Fatalf("Command was not killed in time, took %s", duration)
+	}
+
+	// The process outside of the process group keeps the output open, so the command is abandoned
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	begin = time.Now()
+	_, err = runCommand(ctx, "sh", "-c", `trap "" TERM; setsid sleep 10 & wait`)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the deadline to be exceeded, Got: %v", err)
+	}
+	if duration := time.Since(begin); duration > 2*lctlKillDelay+5*time.Second {
+		t.Fatalf("Command was not abandoned in time, took %s", duration)
+	}
+}
+
+// testCommandRunner returns the recorded output for the lctl calls.
+type testCommandRunner struct {
+	output map[string]string
+	calls  []string
+}
+
+func (r *testCommandRunner) LookPath(name string) error {
+	return nil
+}
+
+func (r *testCommandRunner) Run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	r.calls = append(r.calls, command)
+	output, exists := r.output[command]
+	if !exists {
+		return nil, fmt.Errorf("unexpected command %q", command)
+	}
+	return []byte(output), nil
+}
+
+func TestLctlCommandRunner(t *testing.T) {
+	runner := &testCommandRunner{output: map[string]string{
+		"sudo lctl get_param mdd.*-*.changelog_users": `mdd.lustrefs-MDT0000.changelog_users=
+current index: 34
+ID    index (idle seconds)
+cl1   30 (12)
+`,
+	}}
+	config := Config{Mdt: extended, CommandRunner: runner}
+	ch := make(chan prometheus.Metric, 10)
+	if err := newLustreLctlSource(config).Update(context.Background(), ch); err != nil {
+		t.Fatal(err)
+	}
+	close(ch)
+	if len(ch) != 3 {
+		t.Fatalf("Retrieved an unexpected number of metrics. Expected: %d, Got: %d", 3, len(ch))
+	}
+	if len(runner.calls) != 1 {
+		t.Fatalf("Retrieved unexpected commands: %v", runner.calls)
+	}
+
+	// The MDT collector of another config is independent of the first one
+	if source := newLustreLctlSource(Config{Mdt: disabled, CommandRunner: runner}); len(source.Collectors()) != 0 {
+		t.Fatalf("Retrieved unexpected collectors of the disabled MDT: %v", source.Collectors())
+	}
+}
@@ -0,0 +1,110 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// reasons for rejected metrics
+	rejectedInvalid   string = "invalid"
+	rejectedDuplicate string = "duplicate"
+)
+
+// newConstMetric creates a metric with sanitized label values using the descriptor added for the metric and its labels.
+// It returns nil if the metric cannot be created, e.g. if no descriptor has been added for its labels.
+func (l *descriptorList) newConstMetric(valueType prometheus.ValueType, labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
+	fqName := prometheus.BuildFQName(Namespace, "", name)
+	sanitizedValues := make([]string, len(labelValues))
+	for i, labelValue := range labelValues {
+		sanitizedValues[i] = labelValue
+		if !utf8.ValidString(labelValue) {
+			sanitizedValues[i] = strings.ToValidUTF8(labelValue, string(utf8.RuneError))
+			l.telemetry.sanitized(fqName)
+		}
+	}
+	desc, err := l.desc(fqName, helpText, labels)
+	var metric prometheus.Metric
+	if err == nil {
+		metric, err = prometheus.NewConstMetric(desc, valueType, value, sanitizedValues...)
+	}
+	if err != nil {
+		log.Errorf("Dropping metric %s with labels %v and values %q: %s", fqName, labels, sanitizedValues, err)
+		l.telemetry.rejected(fqName, rejectedInvalid)
+		return nil
+	}
+	return metric
+}
+
+// sendMetric sends the metric to the channel unless it could not be created.
+func sendMetric(ch chan<- prometheus.Metric, metric prometheus.Metric) {
+	if metric != nil {
+		ch <- metric
+	}
+}
+
+// FilterMetrics forwards the metrics from in to out until in is closed.
+// Metrics that could not be created and duplicates of series already forwarded are dropped,
+// since the registry fails the whole gather on them. The dropped metrics are recorded to t, which may be nil.
+func (t *Telemetry) FilterMetrics(in <-chan prometheus.Metric, out chan<- prometheus.Metric) {
+	seen := map[string]struct{}{}
+	for metric := range in {
+		if metric == nil {
+			continue
+		}
+		key, err := seriesKey(metric)
+		if err != nil {
+			log.Errorf("Dropping invalid metric %s: %s", metric.Desc(), err)
+			t.rejected(descName(metric.Desc()), rejectedInvalid)
+			continue
+		}
+		if _, exists := seen[key]; exists {
+			log.Debugf("Dropping duplicate series: %s", key)
+			t.rejected(descName(metric.Desc()), rejectedDuplicate)
+			continue
+		}
+		seen[key] = struct{}{}
+		out <- metric
+	}
+}
+
+// seriesKey returns the metric name and label pairs identifying the series of the metric.
+func seriesKey(metric prometheus.Metric) (string, error) {
+	var m dto.Metric
+	if err := metric.Write(&m); err != nil {
+		return "", err
+	}
+	var key strings.Builder
+	key.WriteString(descName(metric.Desc()))
+	for _, label := range m.Label {
+		key.WriteString("\xff" + label.GetName() + "\xff" + label.GetValue())
+	}
+	return key.String(), nil
+}
+
+// descName extracts the fully-qualified metric name from the descriptor, which does not expose it otherwise.
+func descName(desc *prometheus.Desc) string {
+	s := desc.String()
+	const prefix = `fqName: "`
+	start := strings.Index(s, prefix)
+	if start < 0 {
+		return s
+	}
+	s = s[start+len(prefix):]
+	if end := strings.Index(s, `"`); end >= 0 {
+		return s[:end]
+	}
+	return s
+}
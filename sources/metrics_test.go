@@ -0,0 +1,180 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestNewConstMetric(t *testing.T) {
+	l := descriptorList{telemetry: NewTelemetry()}
+	l.addDescriptor("job_test_total", "Test", []string{"jobid"})
+	l.addDescriptor("mismatch_test", "Test", []string{"component", "target"})
+	metric := l.newConstMetric(prometheus.CounterValue, []string{"jobid"}, []string{"job\xff1"}, "job_test_total", "Test", 1)
+	if metric == nil {
+		t.Fatal("Expected a metric with a sanitized label value")
+	}
+	var m dto.Metric
+	if err := metric.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if value := m.Label[0].GetValue(); value != "job�1" {
+		t.Fatalf("Retrieved an unexpected label value. Expected: %q, Got: %q", "job�1", value)
+	}
+	if count := testutil.ToFloat64(l.telemetry.sanitizedLabelValues.WithLabelValues("lustre_job_test_total")); count != 1 {
+		t.Fatalf("Retrieved an unexpected number of sanitized label values. Expected: 1, Got: %g", count)
+	}
+
+	if metric := l.newConstMetric(prometheus.GaugeValue, []string{"component", "target"}, []string{"ost"}, "mismatch_test", "Test", 1); metric != nil {
+		t.Fatalf("Expected no metric with mismatching label values, Got: %s", metric.Desc())
+	}
+	if metric := l.newConstMetric(prometheus.GaugeValue, []string{"jobid"}, []string{"1"}, "unknown_test", "Test", 1); metric != nil {
+		t.Fatalf("Expected no metric without a descriptor, Got: %s", metric.Desc())
+	}
+	if count := testutil.ToFloat64(l.telemetry.rejectedMetrics.WithLabelValues("lustre_mismatch_test", rejectedInvalid)); count != 1 {
+		t.Fatalf("Retrieved an unexpected number of rejected metrics. Expected: 1, Got: %g", count)
+	}
+}
+
+func TestFilterMetrics(t *testing.T) {
+	var l descriptorList
+	l.addDescriptor("duplicate_test", "Test", []string{"target"})
+	l.addDescriptor("other_test", "Test", []string{"target"})
+	in := make(chan prometheus.Metric, 5)
+	in <- l.gaugeMetric([]string{"target"}, []string{"lustrefs-OST0000"}, "duplicate_test", "Test", 1)
+	in <- nil
+	in <- l.gaugeMetric([]string{"target"}, []string{"lustrefs-OST0001"}, "duplicate_test", "Test", 2)
+	in <- l.gaugeMetric([]string{"target"}, []string{"lustrefs-OST0000"}, "duplicate_test", "Test", 3)
+	in <- l.gaugeMetric([]string{"target"}, []string{"lustrefs-OST0000"}, "other_test", "Test", 4)
+	close(in)
+
+	out := make(chan prometheus.Metric, 5)
+	telemetry := NewTelemetry()
+	telemetry.FilterMetrics(in, out)
+	close(out)
+
+	var values []float64
+	for metric := range out {
+		var m dto.Metric
+		if err := metric.Write(&m); err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, m.Gauge.GetValue())
+	}
+	if len(values) != 3 || values[0] != 1 || values[1] != 2 || values[2] != 4 {
+		t.Fatalf("Retrieved unexpected metrics. Expected: [1 2 4], Got: %v", values)
+	}
+	if count := testutil.ToFloat64(telemetry.rejectedMetrics.WithLabelValues("lustre_duplicate_test", rejectedDuplicate)); count != 1 {
+		t.Fatalf("Retrieved an unexpected number of duplicates. Expected: 1, Got: %g", count)
+	}
+}
+
+// collectorFunc describes the descriptors of a list and collects the metrics of a function.
+type collectorFunc struct {
+	*descriptorList
+	collect func(ch chan<- prometheus.Metric)
+}
+
+func (c collectorFunc) Collect(ch chan<- prometheus.Metric) {
+	c.collect(ch)
+}
+
+func TestAddDescriptor(t *testing.T) {
+	var l descriptorList
+	l.addDescriptor("descriptor_test", "Test", withTargetLabels("operation")...)
+	l.addDescriptor("descriptor_test", "Test", withTargetLabels("operation")...)
+	if len(l.described) != 1 {
+		t.Fatalf("Retrieved an unexpected number of described descriptors. Expected: 1, Got: %d", len(l.described))
+	}
+
+	ostLabels, ostLabelValues := targetLabels("ost", "lustrefs-OST0000", nil)
+	metric := l.gaugeMetric(append(ostLabels, "operation"), append(ostLabelValues, "read"), "descriptor_test", "Test", 1)
+	if metric == nil {
+		t.Fatal("Expected a metric with the labels of an OST")
+	}
+	osdLabels, osdLabelValues := targetLabels("ost", "osd", nil)
+	other := l.gaugeMetric(append(osdLabels, "operation"), append(osdLabelValues, "read"), "descriptor_test", "Test", 1)
+	if other == nil || other.Desc() == metric.Desc() {
+		t.Fatal("Expected a metric with its own descriptor for the labels of a target outside the naming scheme")
+	}
+	var m dto.Metric
+	if err := other.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Label) != len(osdLabels)+1 {
+		t.Fatalf("Retrieved an unexpected number of labels. Expected: %d, Got: %d", len(osdLabels)+1, len(m.Label))
+	}
+	if metric := l.gaugeMetric([]string{"jobid"}, []string{"1"}, "descriptor_test", "Test", 1); metric != nil {
+		t.Fatalf("Expected no metric with labels missing in the descriptors, Got: %s", metric.Desc())
+	}
+
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(collectorFunc{&l, func(ch chan<- prometheus.Metric) { ch <- metric; ch <- other }}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Gather(); err != nil {
+		t.Fatalf("Expected the metrics of different label sets to be gathered, Got: %s", err)
+	}
+	var conflicting descriptorList
+	conflicting.addDescriptor("descriptor_test", "Conflicting help", withTargetLabels("operation")...)
+	if err := registry.Register(collectorFunc{&conflicting, func(ch chan<- prometheus.Metric) {}}); err == nil {
+		t.Fatal("Expected the conflicting descriptor to fail the registration")
+	}
+}
+
+func TestTargetLabelDescriptors(t *testing.T) {
+	l := descriptorList{telemetry: NewTelemetry()}
+	l.addDescriptor("target_descriptor_test", "Test", withTargetLabels("operation")...)
+
+	// Every shape of target name accepted by parseTargetName, with and without a known mount point
+	names := []string{
+		"lustrefs-OST0004",
+		"lustrefs-MDT0000",
+		"lustrefs-QMT0000",
+		"MGS",
+		"lustrefs-MDT0000-lwp-OST0002",
+		"lustrefs-OST0003-osc-MDT0000",
+		"lustrefs-MDT0001-osp-MDT0000",
+		"lustrefs-OST0000-osc-ffff88105db50000",
+		"lustrefs-MDT0000-mdc-ffff88105db50000",
+		"lustrefs-ffff88105db50000",
+		"lustrefs-clilmv-ffff88105db50000",
+		"osd",
+	}
+	var metrics []prometheus.Metric
+	for operation, mounts := range map[string]lustreMounts{"read": nil, "write": {"lustrefs": "/lustre/fs"}} {
+		for _, name := range names {
+			labels, labelValues := targetLabels("ost", name, mounts)
+			metric := l.counterMetric(append(labels, "operation"), append(labelValues, operation), "target_descriptor_test", "Test", 1)
+			if metric == nil {
+				t.Fatalf("Expected a metric for the target %s with the labels %v", name, labels)
+			}
+			metrics = append(metrics, metric)
+		}
+	}
+	if count := testutil.ToFloat64(l.telemetry.rejectedMetrics.WithLabelValues("lustre_target_descriptor_test", rejectedInvalid)); count != 0 {
+		t.Fatalf("Retrieved an unexpected number of rejected metrics. Expected: 0, Got: %g", count)
+	}
+
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(collectorFunc{&l, func(ch chan<- prometheus.Metric) {
+		for _, metric := range metrics {
+			ch <- metric
+		}
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := registry.Gather(); err != nil {
+		t.Fatalf("Expected the metrics of all targets to be gathered, Got: %s", err)
+	}
+}
@@ -0,0 +1,47 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import "path/filepath"
+
+// Paths contains the roots of the filesystems the sources read from.
+// The roots can be changed to read the files of a host mounted into a container, e.g. '/host/proc',
+// or the test fixtures, e.g. 'proc' (without the leading '/').
+type Paths struct {
+	// Proc is the root of procfs
+	Proc string
+	// Sys is the root of sysfs
+	Sys string
+	// Debug is the root of debugfs
+	Debug string
+	// Lctl is the directory of the recorded lctl output read if the config has no CommandRunner.
+	// The output of 'lctl get_param mdd.*-*.changelog_users' is read from 'mdd/*-*/changelog_users' within it.
+	Lctl string
+	// MountInfo is the mount table used to resolve client instances to their filesystem name and mount point.
+	// Both the '/proc/self/mountinfo' and the '/proc/mounts' format are supported.
+	// If empty, 'self/mountinfo' within Proc is used.
+	MountInfo string
+}
+
+// DefaultPaths returns the roots of the filesystems on the local node.
+func DefaultPaths() Paths {
+	return Paths{
+		Proc:  "/proc",
+		Sys:   "/sys",
+		Debug: "/sys/kernel/debug",
+		Lctl:  "lctl",
+	}
+}
+
+func (p Paths) mountInfo() string {
+	if p.MountInfo != "" {
+		return p.MountInfo
+	}
+	return filepath.Join(p.Proc, "self/mountinfo")
+}
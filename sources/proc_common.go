@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -35,9 +36,7 @@ const (
 )
 
 var (
-	numRegexPattern      = regexp.MustCompile(`[0-9]*\.[0-9]+|[0-9]+`)
-	jobidRegexPattern    = regexp.MustCompile(`(?m:job_id: *([\d\w\-_.:+/() ]*)$)`)
-	jobStatsRegexPattern = regexp.MustCompile(`(?ms:job_id:.*?$.*?(?:-|\z))`)
+	numRegexPattern = regexp.MustCompile(`[0-9]*\.[0-9]+|[0-9]+`)
 )
 
 type prometheusType func([]string, []string, string, string, float64) prometheus.Metric
@@ -58,6 +57,7 @@ type lustreStatsMetric struct {
 	value           float64
 	extraLabel      string
 	extraLabelValue string
+	timestamp       time.Time // snapshot_time of the file or job, zero if there is none
 }
 
 type lustreHelpStruct struct {
@@ -111,19 +111,6 @@ func regexCaptureNumbers(textToMatch string) (matchedNumbers []string) {
 	return matchedNumbers
 }
 
-func regexCaptureJobid(textToMatch string) string {
-	match := jobidRegexPattern.FindStringSubmatch(textToMatch)
-	if len(match) != 2 {
-		return ""
-	}
-	return match[1]
-}
-
-func regexCaptureJobStats(textToMatch string) (matchedJobStats []string) {
-	matchedJobStats = jobStatsRegexPattern.FindAllString(textToMatch, -1)
-	return matchedJobStats
-}
-
 func parseFileElements(path string, directoryDepth int) (name string, nodeName string, err error) {
 	pathElements := strings.Split(path, "/")
 	pathLen := len(pathElements)
@@ -131,12 +118,15 @@ func parseFileElements(path string, directoryDepth int) (name string, nodeName s
 		return "", "", fmt.Errorf("path did not return at least one element")
 	}
 	name = pathElements[pathLen-1]
-	nodeName = pathElements[pathLen-2-directoryDepth]
-	nodeName = strings.TrimPrefix(nodeName, "filter-")
-	nodeName = strings.TrimSuffix(nodeName, "_UUID")
+	nodeName = trimNamespaceName(pathElements[pathLen-2-directoryDepth])
 	return name, nodeName, nil
 }
 
+// trimNamespaceName returns the target name of a lock namespace like 'filter-lustrefs-OST0000_UUID'.
+func trimNamespaceName(name string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(name, "filter-"), "_UUID")
+}
+
 func convertToBytes(s string) string {
 	if len(s) < 1 {
 		return s
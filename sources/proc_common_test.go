@@ -160,62 +160,3 @@ func TestConvertToBytes(t *testing.T) {
 		}
 	}
 }
-
-func TestMultipleGetJobStats(t *testing.T) {
-	testJobStatsBlock := `job_stats:
-	- job_id:          24
-	  snapshot_time:   1510782606
-	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
-	  write_bytes:     { samples:       64575, unit: bytes, min:    4096, max: 4194304, sum:    215147593728 }
-	  getattr:         { samples:           7, unit:  reqs }
-	  setattr:         { samples:          43, unit:  reqs }
-	  punch:           { samples:           1, unit:  reqs }
-	  sync:            { samples:           8, unit:  reqs }
-	  destroy:         { samples:          12, unit:  reqs }
-	  create:          { samples:           5, unit:  reqs }
-	  statfs:          { samples:           6, unit:  reqs }
-	  get_info:        { samples:          23, unit:  reqs }
-	  set_info:        { samples:          74, unit:  reqs }
-	  quotactl:        { samples:           9, unit:  reqs }
-	- job_id:          26
-	  snapshot_time:   1510782606
-	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
-	  write_bytes:     { samples:       56048, unit: bytes, min:    4096, max: 4194304, sum:    185838792704 }
-	  getattr:         { samples:           7, unit:  reqs }
-	  setattr:         { samples:          43, unit:  reqs }
-	  punch:           { samples:           1, unit:  reqs }
-	  sync:            { samples:           8, unit:  reqs }
-	  destroy:         { samples:          12, unit:  reqs }
-	  create:          { samples:           5, unit:  reqs }
-	  statfs:          { samples:           6, unit:  reqs }
-	  get_info:        { samples:          23, unit:  reqs }
-	  set_info:        { samples:          74, unit:  reqs }
-	  quotactl:        { samples:           9, unit:  reqs }
-	- job_id:          28
-	  snapshot_time:   1510782606
-	  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }
-	  write_bytes:     { samples:       64208, unit: bytes, min:    4096, max: 4194304, sum:    213963751424 }
-	  getattr:         { samples:           7, unit:  reqs }
-	  setattr:         { samples:          43, unit:  reqs }
-	  punch:           { samples:           1, unit:  reqs }
-	  sync:            { samples:           8, unit:  reqs }
-	  destroy:         { samples:          12, unit:  reqs }
-	  create:          { samples:           5, unit:  reqs }
-	  statfs:          { samples:           6, unit:  reqs }
-	  get_info:        { samples:          23, unit:  reqs }
-	  set_info:        { samples:          74, unit:  reqs }
-	  quotactl:        { samples:           9, unit:  reqs }`
-	expectedJobStats := 3
-
-	capturedJobStats := regexCaptureJobStats(testJobStatsBlock)
-
-	if l := len(capturedJobStats); l != expectedJobStats {
-		t.Fatalf("Retrieved an unexpected number of regex matches. Expected: %d, Got: %d", expectedJobStats, l)
-	}
-
-	exptectedLastJobStatEntry := "job_id:          28\n\t  snapshot_time:   1510782606\n\t  read_bytes:      { samples:         125, unit: bytes, min:    4096, max:    4096, sum:          512000 }\n\t  write_bytes:     { samples:       64208, unit: bytes, min:    4096, max: 4194304, sum:    213963751424 }\n\t  getattr:         { samples:           7, unit:  reqs }\n\t  setattr:         { samples:          43, unit:  reqs }\n\t  punch:           { samples:           1, unit:  reqs }\n\t  sync:            { samples:           8, unit:  reqs }\n\t  destroy:         { samples:          12, unit:  reqs }\n\t  create:          { samples:           5, unit:  reqs }\n\t  statfs:          { samples:           6, unit:  reqs }\n\t  get_info:        { samples:          23, unit:  reqs }\n\t  set_info:        { samples:          74, unit:  reqs }\n\t  quotactl:        { samples:           9, unit:  reqs }"
-
-	if capturedJobStats[2] != exptectedLastJobStatEntry {
-		t.Fatal("Comparision with last job stat entry failed.")
-	}
-}
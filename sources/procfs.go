@@ -14,13 +14,18 @@
 package sources
 
 import (
-	"errors"
+	"bytes"
+	"context"
+	"fmt"
 	"io/ioutil"
+	"math/bits"
 	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/GSI-HPC/lustre_exporter/lustre"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 )
@@ -37,6 +42,11 @@ const (
 	writeTotalHelp   string = "The total number of bytes that have been written."
 	jobStatsHelp     string = "Number of operations the filesystem has performed."
 	statsHelp        string = "Number of operations the filesystem has performed."
+	mdStatsHelp      string = "Number of metadata operations the client has performed on the MDT."
+
+	// Help text dedicated to the times of the 'job_stats' files
+	jobStartTimeHelp  string = "Time in seconds since the epoch the job statistics were started."
+	jobLastUpdateHelp string = "Time in seconds since the epoch the job statistics were last updated."
 
 	// Help text dedicated to the 'brw_stats' file
 	pagesPerBlockRWHelp    string = "Total number of pages per block RPC."
@@ -50,6 +60,27 @@ const (
 	rpcsInFlightHelp string = "Current number of RPCs that are processing during the snapshot."
 	offsetHelp       string = "Current RPC offset by size."
 
+	// Help text dedicated to the modify section of the 'rpc_stats' file
+	modifyRPCsInFlightHelp string = "Total number of modify RPCs by the number of modify RPCs in flight when they were sent."
+
+	// Help text dedicated to the 'rename_stats' file
+	renameHelp string = "Total number of renames by rename type and directory size in bytes."
+
+	// Help text dedicated to the 'target_obd' file
+	lmvTargetActiveHelp string = "Returns '1' if the MDT is active from the client's view"
+
+	// Help text dedicated to the 'extents_stats' and 'offset_stats' files
+	extentsHelp           string = "Total number of client I/O calls by extent size."
+	extentsPerProcessHelp string = "Total number of client I/O calls per process by extent size."
+	offsetExtentsHelp     string = "Number of the most recent discontiguous client I/O ranges by smallest extent size."
+
+	// Help text dedicated to the 'prealloc_status' file
+	preallocStatusCodeHelp string = "Object precreation status of the OSP device as error code, 0 refers to 'ok'"
+	preallocStatusHelp     string = "Object precreation status of the OSP device, the current status is labelled with value 1"
+
+	// Help text dedicated to the 'version' file
+	versionInfoHelp string = "Version of the running Lustre modules, value is always '1'"
+
 	// Help text dedicated to the 'encrypt_page_pools' file
 	physicalPagesHelp     string = "Capacity of physical memory."
 	pagesPerPoolHelp      string = "Number of pages per pool."
@@ -68,24 +99,27 @@ const (
 	outOfMemHelp          string = "Total number of out of memory requests."
 
 	//repeated strings replaced by constants
-	mdStats          string = "md_stats"
-	encryptPagePools string = "encrypt_page_pools"
+	mdStats                string = "md_stats"
+	encryptPagePools       string = "encrypt_page_pools"
+	extentsStats           string = "extents_stats"
+	extentsStatsPerProcess string = "extents_stats_per_process"
+	offsetStats            string = "offset_stats"
+	preallocStatus         string = "prealloc_status"
+	targetObd              string = "target_obd"
+	renameStats            string = "rename_stats"
 )
 
-var (
-	// OstEnabled specifies whether to collect OST metrics
-	OstEnabled string
-	// MdtEnabled specifies whether to collect MDT metrics
-	MdtEnabled string
-	// MgsEnabled specifies whether to collect MGS metrics
-	MgsEnabled string
-	// MdsEnabled specifies whether to collect MDS metrics
-	MdsEnabled string
-	// ClientEnabled specifies whether to collect Client metrics
-	ClientEnabled string
-	// GenericEnabled specifies whether to collect Generic metrics
-	GenericEnabled string
-)
+// preallocStatusNames maps the error codes of the OSP precreation status to their names.
+var preallocStatusNames = map[int]string{
+	0:    "ok",
+	-5:   "EIO",
+	-11:  "EAGAIN",
+	-19:  "ENODEV",
+	-28:  "ENOSPC",
+	-30:  "EROFS",
+	-107: "ENOTCONN",
+	-110: "ETIMEDOUT",
+}
 
 type lustreJobsMetric struct {
 	jobID string
@@ -98,6 +132,13 @@ type lustreBRWMetric struct {
 	value     string
 }
 
+type lustreExtentsMetric struct {
+	pid       string
+	operation string
+	size      string
+	value     string
+}
+
 type multistatParsingStruct struct {
 	index   int
 	pattern string
@@ -108,58 +149,62 @@ func init() {
 }
 
 type lustreProcFsSource struct {
+	descriptorList
 	lustreProcMetrics []lustreProcMetric
-	basePath          string
+	config            Config
+	layouts           layoutCache
 }
 
 func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"obdfilter/*-OST*": {
-			{"brw_size", "brw_size_megabytes", "Block read/write size in megabytes", gaugeMetric, false, extended},
-			{"grant_compat_disable", "grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gaugeMetric, false, extended},
-			{"job_cleanup_interval", "job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gaugeMetric, false, extended},
-			{"job_stats", "job_read_samples_total", readSamplesHelp, counterMetric, false, core},
-			{"job_stats", "job_read_minimum_size_bytes", readMinimumHelp, gaugeMetric, false, core},
-			{"job_stats", "job_read_maximum_size_bytes", readMaximumHelp, gaugeMetric, false, core},
-			{"job_stats", "job_read_bytes_total", readTotalHelp, counterMetric, false, core},
-			{"job_stats", "job_write_samples_total", writeSamplesHelp, counterMetric, false, core},
-			{"job_stats", "job_write_minimum_size_bytes", writeMinimumHelp, gaugeMetric, false, extended},
-			{"job_stats", "job_write_maximum_size_bytes", writeMaximumHelp, gaugeMetric, false, extended},
-			{"job_stats", "job_write_bytes_total", writeTotalHelp, counterMetric, false, core},
-			{"job_stats", "job_stats_total", jobStatsHelp, counterMetric, true, core},
-			{"num_exports", "exports_total", "Total number of times the pool has been exported", counterMetric, false, core},
-			{"recovery_time_hard", "recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gaugeMetric, false, extended},
-			{"recovery_time_soft", "recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", gaugeMetric, false, extended},
-			{"stats", "read_samples_total", readSamplesHelp, counterMetric, false, core},
-			{"stats", "read_minimum_size_bytes", readMinimumHelp, gaugeMetric, false, extended},
-			{"stats", "read_maximum_size_bytes", readMaximumHelp, gaugeMetric, false, extended},
-			{"stats", "read_bytes_total", readTotalHelp, counterMetric, false, core},
-			{"stats", "write_samples_total", writeSamplesHelp, counterMetric, false, core},
-			{"stats", "write_minimum_size_bytes", writeMinimumHelp, gaugeMetric, false, extended},
-			{"stats", "write_maximum_size_bytes", writeMaximumHelp, gaugeMetric, false, extended},
-			{"stats", "write_bytes_total", writeTotalHelp, counterMetric, false, core},
-			{"stats", "stats_total", statsHelp, counterMetric, true, core},
-			{"tot_dirty", "exports_dirty_total", "Total number of exports that have been marked dirty", counterMetric, false, core},
-			{"tot_granted", "exports_granted_total", "Total number of exports that have been marked granted", counterMetric, false, core},
-			{"tot_pending", "exports_pending_total", "Total number of exports that have been marked pending", counterMetric, false, core},
+			{"brw_size", "brw_size_megabytes", "Block read/write size in megabytes", s.gaugeMetric, false, extended},
+			{"grant_compat_disable", "grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", s.gaugeMetric, false, extended},
+			{"job_cleanup_interval", "job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", s.gaugeMetric, false, extended},
+			{"job_stats", "job_read_samples_total", readSamplesHelp, s.counterMetric, false, core},
+			{"job_stats", "job_read_minimum_size_bytes", readMinimumHelp, s.gaugeMetric, false, core},
+			{"job_stats", "job_read_maximum_size_bytes", readMaximumHelp, s.gaugeMetric, false, core},
+			{"job_stats", "job_read_bytes_total", readTotalHelp, s.counterMetric, false, core},
+			{"job_stats", "job_write_samples_total", writeSamplesHelp, s.counterMetric, false, core},
+			{"job_stats", "job_write_minimum_size_bytes", writeMinimumHelp, s.gaugeMetric, false, extended},
+			{"job_stats", "job_write_maximum_size_bytes", writeMaximumHelp, s.gaugeMetric, false, extended},
+			{"job_stats", "job_write_bytes_total", writeTotalHelp, s.counterMetric, false, core},
+			{"job_stats", "job_stats_total", jobStatsHelp, s.counterMetric, true, core},
+			{"job_stats", "job_start_time_seconds", jobStartTimeHelp, s.gaugeMetric, false, extended},
+			{"job_stats", "job_last_update_time_seconds", jobLastUpdateHelp, s.gaugeMetric, false, extended},
+			{"num_exports", "exports_total", "Total number of times the pool has been exported", s.counterMetric, false, core},
+			{"recovery_time_hard", "recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", s.gaugeMetric, false, extended},
+			{"recovery_time_soft", "recovery_time_soft_seconds", "Duration in seconds for a client to attempt to reconnect after a crash (automatically incremented if servers are still in an error state)", s.gaugeMetric, false, extended},
+			{"stats", "read_samples_total", readSamplesHelp, s.counterMetric, false, core},
+			{"stats", "read_minimum_size_bytes", readMinimumHelp, s.gaugeMetric, false, extended},
+			{"stats", "read_maximum_size_bytes", readMaximumHelp, s.gaugeMetric, false, extended},
+			{"stats", "read_bytes_total", readTotalHelp, s.counterMetric, false, core},
+			{"stats", "write_samples_total", writeSamplesHelp, s.counterMetric, false, core},
+			{"stats", "write_minimum_size_bytes", writeMinimumHelp, s.gaugeMetric, false, extended},
+			{"stats", "write_maximum_size_bytes", writeMaximumHelp, s.gaugeMetric, false, extended},
+			{"stats", "write_bytes_total", writeTotalHelp, s.counterMetric, false, core},
+			{"stats", "stats_total", statsHelp, s.counterMetric, true, core},
+			{"tot_dirty", "exports_dirty_total", "Total number of exports that have been marked dirty", s.counterMetric, false, core},
+			{"tot_granted", "exports_granted_total", "Total number of exports that have been marked granted", s.counterMetric, false, core},
+			{"tot_pending", "exports_pending_total", "Total number of exports that have been marked pending", s.counterMetric, false, core},
 		},
 		"osd-*/*-OST*": {
-			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
-			{"brw_stats", "pages_per_bulk_rw_total", pagesPerBlockRWHelp, counterMetric, false, extended},
-			{"brw_stats", "discontiguous_pages_total", discontiguousPagesHelp, counterMetric, false, extended},
-			{"brw_stats", "disk_io", diskIOsInFlightHelp, gaugeMetric, false, core},
-			{"brw_stats", "io_time_milliseconds_total", ioTimeHelp, counterMetric, false, core},
-			{"brw_stats", "disk_io_total", diskIOSizeHelp, counterMetric, false, core},
-			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
-			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
-			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", gaugeMetric, false, core},
-			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", gaugeMetric, false, core},
-			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", gaugeMetric, false, core},
+			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", s.gaugeMetric, false, core},
+			{"brw_stats", "pages_per_bulk_rw_total", pagesPerBlockRWHelp, s.counterMetric, false, extended},
+			{"brw_stats", "discontiguous_pages_total", discontiguousPagesHelp, s.counterMetric, false, extended},
+			{"brw_stats", "disk_io", diskIOsInFlightHelp, s.gaugeMetric, false, core},
+			{"brw_stats", "io_time_milliseconds_total", ioTimeHelp, s.counterMetric, false, core},
+			{"brw_stats", "disk_io_total", diskIOSizeHelp, s.counterMetric, false, core},
+			{"filesfree", "inodes_free", "The number of inodes (objects) available", s.gaugeMetric, false, core},
+			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", s.gaugeMetric, false, core},
+			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", s.gaugeMetric, false, core},
+			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", s.gaugeMetric, false, core},
+			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", s.gaugeMetric, false, core},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "ost", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "ost", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -170,22 +215,41 @@ func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 func (s *lustreProcFsSource) generateMDTMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"osd-*/*-MDT*": {
-			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
-			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
-			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
-			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", gaugeMetric, false, core},
-			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", gaugeMetric, false, core},
-			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", gaugeMetric, false, core},
+			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", s.gaugeMetric, false, core},
+			{"filesfree", "inodes_free", "The number of inodes (objects) available", s.gaugeMetric, false, core},
+			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", s.gaugeMetric, false, core},
+			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", s.gaugeMetric, false, core},
+			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", s.gaugeMetric, false, core},
+			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", s.gaugeMetric, false, core},
 		},
 		"mdt/*": {
-			{mdStats, "stats_total", statsHelp, counterMetric, true, core},
-			{"num_exports", "exports_total", "Total number of times the pool has been exported", counterMetric, false, core},
-			{"job_stats", "job_stats_total", jobStatsHelp, counterMetric, true, core},
+			{mdStats, "stats_total", statsHelp, s.counterMetric, true, core},
+			{"num_exports", "exports_total", "Total number of times the pool has been exported", s.counterMetric, false, core},
+			{"job_stats", "job_stats_total", jobStatsHelp, s.counterMetric, true, core},
+			{"job_stats", "job_start_time_seconds", jobStartTimeHelp, s.gaugeMetric, false, extended},
+			{"job_stats", "job_last_update_time_seconds", jobLastUpdateHelp, s.gaugeMetric, false, extended},
+			{renameStats, "rename_total", renameHelp, s.counterMetric, false, extended},
+			{"sync_count", "sync_total", "Total number of synchronous commits", s.counterMetric, false, extended},
+			{"async_commit_count", "async_commit_total", "Total number of asynchronous commits", s.counterMetric, false, extended},
+			{"commit_on_sharing", "commit_on_sharing_enabled", "Returns '1' if commit on sharing is enabled", s.gaugeMetric, false, extended},
+		},
+		"osp/*-osc-MDT*": {
+			{"active", "osp_active", "Returns '1' if the OSP device is active for object creation", s.gaugeMetric, false, core},
+			{"destroys_in_flight", "osp_destroys_in_flight", "Current number of object destroys in flight to the OST", s.gaugeMetric, false, extended},
+			{"max_create_count", "osp_max_create_count", "Maximum number of objects to precreate on the OST, 0 disables object creation", s.gaugeMetric, false, core},
+			{preallocStatus, "osp_prealloc_status_code", preallocStatusCodeHelp, s.gaugeMetric, false, core},
+			{preallocStatus, "osp_prealloc_status", preallocStatusHelp, s.gaugeMetric, true, core},
+			{"prealloc_next_id", "osp_prealloc_next_id", "Next object id to be assigned from the precreated objects", s.gaugeMetric, false, core},
+			{"prealloc_last_id", "osp_prealloc_last_id", "Last object id precreated on the OST", s.gaugeMetric, false, core},
+			{"prealloc_reserved", "osp_prealloc_reserved", "Number of precreated objects reserved for object creations in progress", s.gaugeMetric, false, extended},
+			{"sync_changes", "osp_sync_changes", "Current number of changes pending to be synced to the OST", s.gaugeMetric, false, core},
+			{"sync_in_flight", "osp_sync_in_flight", "Current number of sync RPCs in flight to the OST", s.gaugeMetric, false, extended},
+			{"sync_in_progress", "osp_sync_in_progress", "Current number of sync RPCs in progress on the OST", s.gaugeMetric, false, extended},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "mdt", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "mdt", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -196,17 +260,17 @@ func (s *lustreProcFsSource) generateMDTMetricTemplates(filter string) {
 func (s *lustreProcFsSource) generateMGSMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"mgs/MGS/osd/": {
-			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
-			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
-			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
-			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", gaugeMetric, false, core},
-			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", gaugeMetric, false, core},
-			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", gaugeMetric, false, core},
+			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", s.gaugeMetric, false, core},
+			{"filesfree", "inodes_free", "The number of inodes (objects) available", s.gaugeMetric, false, core},
+			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", s.gaugeMetric, false, core},
+			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", s.gaugeMetric, false, core},
+			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", s.gaugeMetric, false, core},
+			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", s.gaugeMetric, false, core},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "mgs", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "mgs", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -218,7 +282,7 @@ func (s *lustreProcFsSource) generateMDSMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "mds", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "mds", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -229,74 +293,90 @@ func (s *lustreProcFsSource) generateMDSMetricTemplates(filter string) {
 func (s *lustreProcFsSource) generateClientMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"llite/*": {
-			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
-			{"checksum_pages", "checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", gaugeMetric, false, extended},
-			{"default_easize", "default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", gaugeMetric, false, extended},
-			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
-			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
-			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", gaugeMetric, false, core},
-			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", gaugeMetric, false, core},
-			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", gaugeMetric, false, core},
-			{"lazystatfs", "lazystatfs_enabled", "Returns '1' if lazystatfs (a non-blocking alternative to statfs) is enabled for the client", gaugeMetric, false, extended},
-			{"max_easize", "maximum_ea_size_bytes", "Maximum Extended Attribute (EA) size in bytes", gaugeMetric, false, extended},
-			{"max_read_ahead_mb", "maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", gaugeMetric, false, extended},
-			{"max_read_ahead_per_file_mb", "maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", gaugeMetric, false, extended},
-			{"max_read_ahead_whole_mb", "maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", gaugeMetric, false, extended},
-			{"statahead_agl", "statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", gaugeMetric, false, extended},
-			{"statahead_max", "statahead_maximum", "Maximum window size for statahead", gaugeMetric, false, extended},
-			{"stats", "read_samples_total", readSamplesHelp, counterMetric, false, core},
-			{"stats", "read_minimum_size_bytes", readMinimumHelp, gaugeMetric, false, extended},
-			{"stats", "read_maximum_size_bytes", readMaximumHelp, gaugeMetric, false, extended},
-			{"stats", "read_bytes_total", readTotalHelp, counterMetric, false, core},
-			{"stats", "write_samples_total", writeSamplesHelp, counterMetric, false, core},
-			{"stats", "write_minimum_size_bytes", writeMinimumHelp, gaugeMetric, false, extended},
-			{"stats", "write_maximum_size_bytes", writeMaximumHelp, gaugeMetric, false, extended},
-			{"stats", "write_bytes_total", writeTotalHelp, counterMetric, false, core},
-			{"stats", "stats_total", statsHelp, counterMetric, true, core},
-			{"xattr_cache", "xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", gaugeMetric, false, extended},
+			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", s.gaugeMetric, false, core},
+			{"checksum_pages", "checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", s.gaugeMetric, false, extended},
+			{"default_easize", "default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", s.gaugeMetric, false, extended},
+			{"filesfree", "inodes_free", "The number of inodes (objects) available", s.gaugeMetric, false, core},
+			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", s.gaugeMetric, false, core},
+			{"kbytesavail", "available_kibibytes", "Number of kibibytes readily available in the pool", s.gaugeMetric, false, core},
+			{"kbytesfree", "free_kibibytes", "Number of kibibytes free in the pool", s.gaugeMetric, false, core},
+			{"kbytestotal", "capacity_kibibytes", "Capacity of the pool in kibibytes", s.gaugeMetric, false, core},
+			{"lazystatfs", "lazystatfs_enabled", "Returns '1' if lazystatfs (a non-blocking alternative to statfs) is enabled for the client", s.gaugeMetric, false, extended},
+			{"max_easize", "maximum_ea_size_bytes", "Maximum Extended Attribute (EA) size in bytes", s.gaugeMetric, false, extended},
+			{"max_read_ahead_mb", "maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", s.gaugeMetric, false, extended},
+			{"max_read_ahead_per_file_mb", "maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", s.gaugeMetric, false, extended},
+			{"max_read_ahead_whole_mb", "maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", s.gaugeMetric, false, extended},
+			{"statahead_agl", "statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", s.gaugeMetric, false, extended},
+			{"statahead_max", "statahead_maximum", "Maximum window size for statahead", s.gaugeMetric, false, extended},
+			{"stats", "read_samples_total", readSamplesHelp, s.counterMetric, false, core},
+			{"stats", "read_minimum_size_bytes", readMinimumHelp, s.gaugeMetric, false, extended},
+			{"stats", "read_maximum_size_bytes", readMaximumHelp, s.gaugeMetric, false, extended},
+			{"stats", "read_bytes_total", readTotalHelp, s.counterMetric, false, core},
+			{"stats", "write_samples_total", writeSamplesHelp, s.counterMetric, false, core},
+			{"stats", "write_minimum_size_bytes", writeMinimumHelp, s.gaugeMetric, false, extended},
+			{"stats", "write_maximum_size_bytes", writeMaximumHelp, s.gaugeMetric, false, extended},
+			{"stats", "write_bytes_total", writeTotalHelp, s.counterMetric, false, core},
+			{"stats", "stats_total", statsHelp, s.counterMetric, true, core},
+			{"xattr_cache", "xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", s.gaugeMetric, false, extended},
+			{extentsStats, "extents_total", extentsHelp, s.counterMetric, false, extended},
+			{offsetStats, "offset_extents", offsetExtentsHelp, s.gaugeMetric, false, extended},
+		},
+		"lmv/*-clilmv-*": {
+			{"activeobd", "lmv_active_targets", "Number of active MDTs in the client's logical metadata volume", s.gaugeMetric, false, core},
+			{"numobd", "lmv_targets", "Number of MDTs in the client's logical metadata volume", s.gaugeMetric, false, core},
+			{targetObd, "lmv_target_active", lmvTargetActiveHelp, s.gaugeMetric, false, core},
 		},
 		"mdc/*": {
-			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, gaugeMetric, true, core},
+			{mdStats, "md_stats_total", mdStatsHelp, s.counterMetric, true, core},
+			{"max_mod_rpcs_in_flight", "maximum_modify_rpcs_in_flight", "Maximum number of modify RPCs in flight to the MDT", s.gaugeMetric, false, extended},
+			{"max_rpcs_in_flight", "maximum_rpcs_in_flight", "Maximum number of RPCs in flight to the MDT", s.gaugeMetric, false, extended},
+			{"rpc_stats", "modify_rpcs_in_flight_total", modifyRPCsInFlightHelp, s.counterMetric, false, core},
+			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, s.gaugeMetric, true, core},
 		},
 		"osc/*": {
-			{"rpc_stats", "pages_per_rpc_total", pagesPerRPCHelp, counterMetric, false, core},
-			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, gaugeMetric, true, core},
-			{"rpc_stats", "rpcs_offset", offsetHelp, gaugeMetric, false, core},
+			{"rpc_stats", "pages_per_rpc_total", pagesPerRPCHelp, s.counterMetric, false, core},
+			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, s.gaugeMetric, true, core},
+			{"rpc_stats", "rpcs_offset", offsetHelp, s.gaugeMetric, false, core},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "client", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "client", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
 		}
 	}
+	// The per-process statistics are labelled by pid and command and therefore only collected on request
+	if s.config.ClientPerProcess && s.config.metricSelected(filter, "client", "llite/*/"+extentsStatsPerProcess, "extents_per_process_total", core) {
+		newMetric := newLustreProcMetric(extentsStatsPerProcess, "extents_per_process_total", "client", "llite/*", extentsPerProcessHelp, false, s.counterMetric)
+		s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
+	}
 }
 
 func (s *lustreProcFsSource) generateGenericMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"sptlrpc": {
-			{"encrypt_page_pools", "physical_pages", physicalPagesHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "pages_per_pool", pagesPerPoolHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "maximum_pages", maxPagesHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "maximum_pools", maxPoolsHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "pages_in_pools", totalPagesHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "free_pages", totalFreeHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "maximum_pages_reached_total", maxPagesReachedHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "grows_total", growsHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "grows_failure_total", growsFailureHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "shrinks_total", shrinksHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "cache_access_total", cacheAccessHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "cache_miss_total", cacheMissingHelp, counterMetric, false, extended},
-			{"encrypt_page_pools", "free_page_low", lowFreeMarkHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "maximum_waitqueue_depth", maxWaitQueueDepthHelp, gaugeMetric, false, extended},
-			{"encrypt_page_pools", "out_of_memory_request_total", outOfMemHelp, counterMetric, false, extended},
+			{"encrypt_page_pools", "physical_pages", physicalPagesHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "pages_per_pool", pagesPerPoolHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "maximum_pages", maxPagesHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "maximum_pools", maxPoolsHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "pages_in_pools", totalPagesHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "free_pages", totalFreeHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "maximum_pages_reached_total", maxPagesReachedHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "grows_total", growsHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "grows_failure_total", growsFailureHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "shrinks_total", shrinksHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "cache_access_total", cacheAccessHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "cache_miss_total", cacheMissingHelp, s.counterMetric, false, extended},
+			{"encrypt_page_pools", "free_page_low", lowFreeMarkHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "maximum_waitqueue_depth", maxWaitQueueDepthHelp, s.gaugeMetric, false, extended},
+			{"encrypt_page_pools", "out_of_memory_request_total", outOfMemHelp, s.counterMetric, false, extended},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "generic", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "generic", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -304,41 +384,113 @@ func (s *lustreProcFsSource) generateGenericMetricTemplates(filter string) {
 	}
 }
 
-func newLustreProcFsSource() LustreSource {
+func newLustreProcFsSource(config Config) LustreSource {
 	var l lustreProcFsSource
-	l.basePath = filepath.Join(ProcLocation, "fs/lustre")
+	l.config = config
+	l.telemetry = config.Telemetry
 	//control which node metrics you pull via flags
-	if OstEnabled != disabled {
-		l.generateOSTMetricTemplates(OstEnabled)
+	if config.Ost != disabled {
+		l.generateOSTMetricTemplates(config.Ost)
 	}
-	if MdtEnabled != disabled {
-		l.generateMDTMetricTemplates(MdtEnabled)
+	if config.Mdt != disabled {
+		l.generateMDTMetricTemplates(config.Mdt)
 	}
-	if MgsEnabled != disabled {
-		l.generateMGSMetricTemplates(MgsEnabled)
+	if config.Mgs != disabled {
+		l.generateMGSMetricTemplates(config.Mgs)
 	}
-	if MdsEnabled != disabled {
-		l.generateMDSMetricTemplates(MdsEnabled)
+	if config.Mds != disabled {
+		l.generateMDSMetricTemplates(config.Mds)
 	}
-	if ClientEnabled != disabled {
-		l.generateClientMetricTemplates(ClientEnabled)
+	if config.Client != disabled {
+		l.generateClientMetricTemplates(config.Client)
 	}
-	if GenericEnabled != disabled {
-		l.generateGenericMetricTemplates(GenericEnabled)
+	if config.Generic != disabled {
+		l.generateGenericMetricTemplates(config.Generic)
+		l.addDescriptor("version_info", versionInfoHelp, []string{"version"})
+	}
+	for _, metric := range l.lustreProcMetrics {
+		l.addDescriptor(metric.promName, metric.helpText, procFsTemplateLabels(metric)...)
 	}
 	return &l
 }
 
-func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
+// procFsTemplateLabels returns the sets of label names of the metrics created from the template.
+func procFsTemplateLabels(metric lustreProcMetric) [][]string {
+	switch metric.filename {
+	case "brw_stats", "rpc_stats":
+		if metric.hasMultipleVals {
+			return withTargetLabels("operation", "size", "type")
+		}
+		return withTargetLabels("operation", "size")
+	case extentsStats, offsetStats:
+		return withTargetLabels("operation", "size")
+	case extentsStatsPerProcess:
+		return withTargetLabels("operation", "size", "pid", "command")
+	case renameStats:
+		return withTargetLabels("type", "size")
+	case "job_stats":
+		if metric.hasMultipleVals {
+			return withTargetLabels("jobid", "operation")
+		}
+		return withTargetLabels("jobid")
+	case stats, mdStats:
+		if metric.hasMultipleVals {
+			return withTargetLabels("operation")
+		}
+	case preallocStatus:
+		if metric.hasMultipleVals {
+			return withTargetLabels("status")
+		}
+	case targetObd:
+		return withTargetLabels("mdt")
+	}
+	return withTargetLabels()
+}
+
+func (s *lustreProcFsSource) Update(ctx context.Context, ch chan<- prometheus.Metric) (err error) {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+// Collectors returns the names of the collectors the source creates metrics for.
+func (s *lustreProcFsSource) Collectors() []string {
+	return templateCollectors(s.lustreProcMetrics)
+}
+
+func (s *lustreProcFsSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection Selection) (err error) {
 	var metricType string
 	var directoryDepth int
 
+	version, layout := s.layouts.detect(s.config.Paths)
+	if version != "" && s.config.Generic != disabled && selection.selected("generic") {
+		sendMetric(ch, s.gaugeMetric([]string{"version"}, []string{version}, "version_info", versionInfoHelp, 1))
+	}
+
+	mounts := lustreMounts{}
+	if s.config.Client != disabled {
+		mounts = loadLustreMounts(s.config.Paths.mountInfo())
+	}
+
+	var errs collectionErrors
 	for _, metric := range s.lustreProcMetrics {
+		if !selection.selected(selectionCollector(metric.source, metric.filename)) {
+			continue
+		}
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
 		directoryDepth = strings.Count(metric.filename, "/")
-		paths, err := filepath.Glob(filepath.Join(s.basePath, metric.path, metric.filename))
+		pattern := filepath.Join(metric.path, metric.filename)
+		telemetry := s.config.Telemetry.newFileTelemetry(metric.source, pattern)
+		paths, err := globPaths(layout.procfs, pattern)
 		if err != nil {
-			return err
+			telemetry.failed()
+			telemetry.done()
+			errs = append(errs, err)
+			continue
 		}
+		paths = s.config.TargetFilter.filterPaths(paths, directoryDepth)
+		telemetry.globbed(len(paths))
 		if paths == nil {
 			continue
 		}
@@ -346,27 +498,38 @@ func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 			metricType = single
 			switch metric.filename {
 			case "brw_stats", "rpc_stats":
-				err = s.parseBRWStats(metric.source, "stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, brwOperation string, brwSize string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target", "operation", "size"}, []string{nodeType, nodeName, brwOperation, brwSize}, name, helpText, value)
-					} else {
-						ch <- metric.metricFunc([]string{"component", "target", "operation", "size", extraLabel}, []string{nodeType, nodeName, brwOperation, brwSize, extraLabelValue}, name, helpText, value)
+				err = s.parseBRWStats(telemetry, metric.source, "stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, brwOperation string, brwSize string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string, snapshotTime time.Time) {
+					labels, labelValues := targetLabels(nodeType, nodeName, mounts)
+					labels, labelValues = append(labels, "operation", "size"), append(labelValues, brwOperation, brwSize)
+					if extraLabelValue != "" {
+						labels, labelValues = append(labels, extraLabel), append(labelValues, extraLabelValue)
 					}
+					sendMetric(ch, s.config.withSnapshotTime(metric.metricFunc(labels, labelValues, name, helpText, value), snapshotTime))
+				})
+			case extentsStats, extentsStatsPerProcess, offsetStats:
+				err = s.parseExtentsStats(telemetry, metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, operation string, size string, pid string, command string, name string, helpText string, value float64) {
+					labels, labelValues := targetLabels(nodeType, nodeName, mounts)
+					labels, labelValues = append(labels, "operation", "size"), append(labelValues, operation, size)
+					if pid != "" {
+						labels, labelValues = append(labels, "pid", "command"), append(labelValues, pid, command)
+					}
+					sendMetric(ch, metric.metricFunc(labels, labelValues, name, helpText, value))
+				})
+			case renameStats:
+				err = s.parseRenameStats(telemetry, metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, renameType string, size string, name string, helpText string, value float64) {
+					labels, labelValues := targetLabels(nodeType, nodeName, mounts)
+					labels, labelValues = append(labels, "type", "size"), append(labelValues, renameType, size)
+					sendMetric(ch, metric.metricFunc(labels, labelValues, name, helpText, value))
 				})
-				if err != nil {
-					return err
-				}
 			case "job_stats":
-				err = s.parseJobStats(metric.source, "job_stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, jobid string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid"}, []string{nodeType, nodeName, jobid}, name, helpText, value)
-					} else {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid", extraLabel}, []string{nodeType, nodeName, jobid, extraLabelValue}, name, helpText, value)
+				err = s.parseJobStats(telemetry, metric.source, "job_stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, jobid string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string, snapshotTime time.Time) {
+					labels, labelValues := targetLabels(nodeType, nodeName, mounts)
+					labels, labelValues = append(labels, "jobid"), append(labelValues, jobid)
+					if extraLabelValue != "" {
+						labels, labelValues = append(labels, extraLabel), append(labelValues, extraLabelValue)
 					}
+					sendMetric(ch, s.config.withSnapshotTime(metric.metricFunc(labels, labelValues, name, helpText, value), snapshotTime))
 				})
-				if err != nil {
-					return err
-				}
 			default:
 				if metric.filename == stats {
 					metricType = stats
@@ -374,77 +537,72 @@ func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 					metricType = mdStats
 				} else if metric.filename == encryptPagePools {
 					metricType = encryptPagePools
+				} else if metric.filename == preallocStatus && metric.hasMultipleVals {
+					metricType = preallocStatus
+				} else if metric.filename == targetObd {
+					metricType = targetObd
 				}
-				err = s.parseFile(metric.source, metricType, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
-					} else {
-						ch <- metric.metricFunc([]string{"component", "target", extraLabel}, []string{nodeType, nodeName, extraLabelValue}, name, helpText, value)
+				err = s.parseFile(telemetry, metric.source, metricType, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string, snapshotTime time.Time) {
+					labels, labelValues := targetLabels(nodeType, nodeName, mounts)
+					if extraLabelValue != "" {
+						labels, labelValues = append(labels, extraLabel), append(labelValues, extraLabelValue)
 					}
+					sendMetric(ch, s.config.withSnapshotTime(metric.metricFunc(labels, labelValues, name, helpText, value), snapshotTime))
 				})
-				if err != nil {
-					return err
-				}
+			}
+			if err != nil {
+				telemetry.failed()
+				errs = append(errs, fmt.Errorf("%s - %s", path, err))
 			}
 		}
+		telemetry.done()
 	}
-	return nil
+	return errs.err()
 }
 
-func getStatsOperationMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
-	operationSlice := []multistatParsingStruct{
-		{pattern: "open", index: 1},
-		{pattern: "close", index: 1},
-		{pattern: "getattr", index: 1},
-		{pattern: "setattr", index: 1},
-		{pattern: "getxattr", index: 1},
-		{pattern: "setxattr", index: 1},
-		{pattern: "statfs", index: 1},
-		{pattern: "seek", index: 1},
-		{pattern: "readdir", index: 1},
-		{pattern: "truncate", index: 1},
-		{pattern: "alloc_inode", index: 1},
-		{pattern: "removexattr", index: 1},
-		{pattern: "unlink", index: 1},
-		{pattern: "inode_permission", index: 1},
-		{pattern: "create", index: 1},
-		{pattern: "get_info", index: 1},
-		{pattern: "set_info_async", index: 1},
-		{pattern: "connect", index: 1},
-		{pattern: "ping", index: 1},
-	}
-	for _, operation := range operationSlice {
-		opStat := regexCaptureString(operation.pattern+" .*", statsFile)
-		if len(opStat) < 1 {
-			continue
-		}
-		r, err := regexp.Compile(" +")
-		if err != nil {
-			continue
-		}
-		bytesSplit := r.Split(opStat, -1)
-		result, err := strconv.ParseFloat(bytesSplit[operation.index], 64)
-		if err != nil {
-			return nil, err
-		}
-		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, result, "operation", operation.pattern))
-	}
-	return metricList, nil
+// statsOperations are the counters of the 'stats' and 'md_stats' files exported as operations.
+var statsOperations = []string{
+	"open", "close", "getattr", "setattr", "getxattr", "setxattr", "statfs", "seek", "readdir", "truncate",
+	"alloc_inode", "removexattr", "unlink", "inode_permission", "create", "get_info", "set_info_async", "connect",
+	"ping",
 }
 
-func getStatsIOMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
-	// bytesSplit is in the following format:
-	// bytesString: {name} {number of samples} 'samples' [{units}] {minimum} {maximum} {sum}
-	// bytesSplit:   [0]    [1]                 [2]       [3]       [4]       [5]       [6]
+// mdcStatsOperations are the counters of the 'md_stats' files of the MDCs exported as operations,
+// including the client side metadata operations like lock intents.
+var mdcStatsOperations = append(append([]string{}, statsOperations...),
+	"get_root", "null_inode", "enqueue", "getattr_name", "intent_lock", "intent_getattr_async",
+	"revalidate_lock", "rename", "fsync", "read_page",
+)
+
+// jobStatsOperations are the counters of the 'job_stats' files exported as operations.
+var jobStatsOperations = []string{
+	"open", "close", "mknod", "link", "unlink", "mkdir", "rmdir", "rename", "getattr", "setattr", "getxattr",
+	"setxattr", "statfs", "sync", "samedir_rename", "crossdir_rename", "punch", "destroy", "create", "get_info",
+	"set_info", "quotactl",
+}
+
+// counterValue selects a value of a counter of a 'stats' or 'job_stats' file.
+type counterValue struct {
+	counter string
+	value   func(lustre.Counter) uint64
+}
+
+// ioCounterValues maps the help texts of the read and write metrics to their values.
+var ioCounterValues = map[string]counterValue{
+	readSamplesHelp:  {"read_bytes", func(c lustre.Counter) uint64 { return c.Samples }},
+	readMinimumHelp:  {"read_bytes", func(c lustre.Counter) uint64 { return c.Min }},
+	readMaximumHelp:  {"read_bytes", func(c lustre.Counter) uint64 { return c.Max }},
+	readTotalHelp:    {"read_bytes", func(c lustre.Counter) uint64 { return c.Sum }},
+	writeSamplesHelp: {"write_bytes", func(c lustre.Counter) uint64 { return c.Samples }},
+	writeMinimumHelp: {"write_bytes", func(c lustre.Counter) uint64 { return c.Min }},
+	writeMaximumHelp: {"write_bytes", func(c lustre.Counter) uint64 { return c.Max }},
+	writeTotalHelp:   {"write_bytes", func(c lustre.Counter) uint64 { return c.Sum }},
+}
+
+func getEncryptPagePoolsMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	// The lines are in the following format, with names of multiple words, e.g. 'max pages reached: 0':
+	// {name}: {value}
 	bytesMap := map[string]multistatParsingStruct{
-		readSamplesHelp:       {pattern: "read_bytes .*", index: 1},
-		readMinimumHelp:       {pattern: "read_bytes .*", index: 4},
-		readMaximumHelp:       {pattern: "read_bytes .*", index: 5},
-		readTotalHelp:         {pattern: "read_bytes .*", index: 6},
-		writeSamplesHelp:      {pattern: "write_bytes .*", index: 1},
-		writeMinimumHelp:      {pattern: "write_bytes .*", index: 4},
-		writeMaximumHelp:      {pattern: "write_bytes .*", index: 5},
-		writeTotalHelp:        {pattern: "write_bytes .*", index: 6},
 		physicalPagesHelp:     {pattern: "physical pages: .*", index: 2},
 		pagesPerPoolHelp:      {pattern: "pages per pool: .*", index: 3},
 		maxPagesHelp:          {pattern: "max pages: .*", index: 2},
@@ -480,223 +638,267 @@ func getStatsIOMetrics(statsFile string, promName string, helpText string) (metr
 	return metricList, nil
 }
 
-func splitBRWStats(statBlock string) (metricList []lustreBRWMetric, err error) {
-	if len(statBlock) == 0 || statBlock == "" {
-		return nil, nil
+func splitRenameStats(statsFile string) (metricList []lustreBRWMetric, err error) {
+	renameType := ""
+	for _, line := range strings.Split(statsFile, "\n") {
+		// The histograms are in the following format:
+		// - [rename type]:
+		//       [directory size]: { samples: [renames], pct: [relative renames (%)], cum_pct: [cumulative renames (%)] }
+		fields := strings.Fields(strings.NewReplacer("{", " ", "}", " ", ",", " ").Replace(line))
+		if len(fields) == 2 && fields[0] == "-" && strings.HasSuffix(fields[1], ":") {
+			renameType = strings.TrimSuffix(fields[1], ":")
+			continue
+		}
+		if len(fields) < 3 || fields[1] != "samples:" || renameType == "" {
+			continue
+		}
+		size := convertToBytes(strings.TrimSuffix(strings.TrimSuffix(fields[0], ":"), "B"))
+		metricList = append(metricList, lustreBRWMetric{size: size, operation: renameType, value: fields[2]})
 	}
+	return metricList, nil
+}
 
-	// Skip the first line of text as it doesn't contain any metrics
-	for _, line := range strings.Split(statBlock, "\n")[1:] {
-		if len(line) > 1 {
-			fields := strings.Fields(line)
-			// Lines are in the following format:
-			// [size] [# read RPCs] [relative read size (%)] [cumulative read size (%)] | [# write RPCs] [relative write size (%)] [cumulative write size (%)]
-			// [0]    [1]           [2]                      [3]                       [4] [5]           [6]                       [7]
-			if len(fields) >= 6 {
-				size, readRPCs, writeRPCs := fields[0], fields[1], fields[5]
-				size = strings.Replace(size, ":", "", -1)
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "read", value: readRPCs})
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "write", value: writeRPCs})
-			} else if len(fields) >= 1 {
-				size, rpcs := fields[0], fields[1]
-				size = strings.Replace(size, ":", "", -1)
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "read", value: rpcs})
-			} else {
-				continue
-			}
+func splitTargetObd(targetObd string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	for _, line := range strings.Split(targetObd, "\n") {
+		// Lines are in the following format:
+		// [index]: [target UUID] [ACTIVE|INACTIVE]
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
 		}
+		value := 0.0
+		if fields[2] == "ACTIVE" {
+			value = 1
+		}
+		target := strings.TrimSuffix(fields[1], "_UUID")
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "mdt", target))
 	}
 	return metricList, nil
 }
 
-func parseStatsFile(helpText string, promName string, path string, hasMultipleVals bool) (metricList []lustreStatsMetric, err error) {
-	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
-	if err != nil {
-		return nil, err
-	}
-	statsFile := string(statsFileBytes[:])
-	var statsList []lustreStatsMetric
-	if hasMultipleVals {
-		statsList, err = getStatsOperationMetrics(statsFile, promName, helpText)
-	} else {
-		statsList, err = getStatsIOMetrics(statsFile, promName, helpText)
-	}
-	if err != nil {
-		return nil, err
-	}
-	if statsList != nil {
-		metricList = append(metricList, statsList...)
+func splitExtentsStats(statsFile string) (metricList []lustreExtentsMetric, err error) {
+	pid := ""
+	for _, line := range strings.Split(statsFile, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "PID:" {
+			pid = fields[1]
+			continue
+		}
+		// Lines are in the following format, with a '+' appended to the end of the last extent range:
+		// [start] - [end] : [read calls] [read %] [read cum %] | [write calls] [write %] [write cum %]
+		elements := strings.SplitN(line, ":", 2)
+		if len(elements) != 2 {
+			continue
+		}
+		extent, values := strings.Fields(elements[0]), strings.Fields(elements[1])
+		if len(extent) != 3 || extent[1] != "-" || len(values) < 7 || values[3] != "|" {
+			continue
+		}
+		size := convertToBytes(extent[0])
+		metricList = append(metricList, lustreExtentsMetric{pid: pid, operation: "read", size: size, value: values[0]})
+		metricList = append(metricList, lustreExtentsMetric{pid: pid, operation: "write", size: size, value: values[4]})
 	}
-
 	return metricList, nil
 }
 
-func getJobStatsIOMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	// opMap matches the given helpText value with the placement of the numeric fields within each metric line.
-	// For example, the number of samples is the first number in the line and has a helpText of readSamplesHelp,
-	// hence the 'index' value of 0. 'pattern' is the regex capture pattern for the desired line.
-	opMap := map[string]multistatParsingStruct{
-		readSamplesHelp:  {index: 0, pattern: "read_bytes"},
-		readMinimumHelp:  {index: 1, pattern: "read_bytes"},
-		readMaximumHelp:  {index: 2, pattern: "read_bytes"},
-		readTotalHelp:    {index: 3, pattern: "read_bytes"},
-		writeSamplesHelp: {index: 0, pattern: "write_bytes"},
-		writeMinimumHelp: {index: 1, pattern: "write_bytes"},
-		writeMaximumHelp: {index: 2, pattern: "write_bytes"},
-		writeTotalHelp:   {index: 3, pattern: "write_bytes"},
-	}
-	// If the metric isn't located in the map, don't try to parse a value for it.
-	if _, exists := opMap[helpText]; !exists {
-		return nil, nil
+func splitOffsetStats(statsFile string) (metricList []lustreExtentsMetric, err error) {
+	operations := map[string]string{"R": "read", "W": "write"}
+	counts := map[lustreExtentsMetric]uint64{}
+	var order []lustreExtentsMetric
+	for _, line := range strings.Split(statsFile, "\n") {
+		// Lines are in the following format:
+		// [R/W] [PID] [range start] [range end] [smallest extent] [largest extent] [offset]
+		// [0]   [1]   [2]           [3]         [4]               [5]              [6]
+		fields := strings.Fields(line)
+		if len(fields) != 7 {
+			continue
+		}
+		operation, ok := operations[fields[0]]
+		if !ok {
+			continue
+		}
+		smallest, err := strconv.ParseUint(fields[4], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		// Group the smallest extents into power of two buckets like the 'extents_stats' file
+		bucket := uint64(0)
+		if smallest > 0 {
+			bucket = 1 << uint(bits.Len64(smallest)-1)
+		}
+		key := lustreExtentsMetric{operation: operation, size: strconv.FormatUint(bucket, 10)}
+		if _, exists := counts[key]; !exists {
+			order = append(order, key)
+		}
+		counts[key]++
 	}
-	pattern := opMap[helpText].pattern
-	opStat := regexCaptureString(pattern+": .*", jobBlock)
-	opNumbers := regexCaptureNumbers(opStat)
-	if len(opNumbers) < 1 {
-		return nil, nil
+	for _, key := range order {
+		key.value = strconv.FormatUint(counts[key], 10)
+		metricList = append(metricList, key)
 	}
-	result, err := strconv.ParseFloat(strings.TrimSpace(opNumbers[opMap[helpText].index]), 64)
-	if err != nil {
-		return nil, err
+	return metricList, nil
+}
+
+func convertStatsFile(stats lustre.StatsFile, promName string, helpText string, hasMultipleVals bool) (metricList []lustreStatsMetric) {
+	if hasMultipleVals {
+		operations := statsOperations
+		if helpText == mdStatsHelp {
+			operations = mdcStatsOperations
+		}
+		for _, operation := range operations {
+			if counter, exists := stats.Counters[operation]; exists {
+				metricList = append(metricList, *newLustreStatsMetric(promName, helpText, float64(counter.Samples), "operation", operation))
+			}
+		}
+	} else if value, exists := ioCounterValues[helpText]; exists {
+		if counter, exists := stats.Counters[value.counter]; exists {
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, float64(value.value(counter)), "", ""))
+		}
 	}
-	if result == 0 {
-		return nil, nil
+	for i := range metricList {
+		metricList[i].timestamp = stats.SnapshotTime
 	}
-	metricList = append(metricList,
-		lustreJobsMetric{jobID: jobID,
-			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "", ""),
-		})
-
-	return metricList, err
-}
-
-func getJobNum(jobBlock string) (jobID string, err error) {
-	jobID = regexCaptureJobid(jobBlock)
-	if jobID == "" {
-		return "", errors.New("No valid jobid found in block: " + jobBlock)
-	}
-	return strings.TrimSpace(jobID), nil
-}
-
-func getJobStatsOperationMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	operationSlice := []multistatParsingStruct{
-		{index: 0, pattern: "open"},
-		{index: 0, pattern: "close"},
-		{index: 0, pattern: "mknod"},
-		{index: 0, pattern: "link"},
-		{index: 0, pattern: "unlink"},
-		{index: 0, pattern: "mkdir"},
-		{index: 0, pattern: "rmdir"},
-		{index: 0, pattern: "rename"},
-		{index: 0, pattern: "getattr"},
-		{index: 0, pattern: "setattr"},
-		{index: 0, pattern: "getxattr"},
-		{index: 0, pattern: "setxattr"},
-		{index: 0, pattern: "statfs"},
-		{index: 0, pattern: "sync"},
-		{index: 0, pattern: "samedir_rename"},
-		{index: 0, pattern: "crossdir_rename"},
-		{index: 0, pattern: "punch"},
-		{index: 0, pattern: "destroy"},
-		{index: 0, pattern: "create"},
-		{index: 0, pattern: "get_info"},
-		{index: 0, pattern: "set_info"},
-		{index: 0, pattern: "quotactl"},
-	}
-	for _, operation := range operationSlice {
-		opStat := regexCaptureString(operation.pattern+": .*", jobBlock)
-		opNumbers := regexCaptureStrings("[0-9]*\\.[0-9]+|[0-9]+", opStat)
-		if len(opNumbers) < 1 {
-			continue
+	return metricList
+}
+
+// unixSeconds returns the time in seconds since the epoch including its fraction.
+func unixSeconds(t time.Time) float64 {
+	return float64(t.Unix()) + float64(t.Nanosecond())/1e9
+}
+
+func getJobStatsIOMetrics(job lustre.JobStats, promName string, helpText string) []lustreStatsMetric {
+	var result float64
+	switch helpText {
+	case jobStartTimeHelp:
+		if !job.StartTime.IsZero() {
+			result = unixSeconds(job.StartTime)
 		}
-		var result float64
-		result, err = strconv.ParseFloat(strings.TrimSpace(opNumbers[operation.index]), 64)
-		if err != nil {
-			return nil, err
+	case jobLastUpdateHelp:
+		if !job.SnapshotTime.IsZero() {
+			result = unixSeconds(job.SnapshotTime)
 		}
-		if result == 0 {
-			continue
+	default:
+		// If the metric isn't located in the map, don't try to parse a value for it.
+		value, exists := ioCounterValues[helpText]
+		if !exists {
+			return nil
 		}
-		metricList = append(metricList,
-			lustreJobsMetric{jobID: jobID,
-				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "operation", operation.pattern),
-			})
+		result = float64(value.value(job.Counters[value.counter]))
+	}
+	if result == 0 {
+		return nil
 	}
-	return metricList, err
+	return []lustreStatsMetric{*newLustreStatsMetric(promName, helpText, result, "", "")}
 }
 
-func parseJobStatsText(jobStats string, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric, err error) {
-	jobs := regexCaptureJobStats(jobStats)
-	if len(jobs) < 1 {
-		return nil, nil
+func getJobStatsOperationMetrics(job lustre.JobStats, promName string, helpText string) (metricList []lustreStatsMetric) {
+	for _, operation := range jobStatsOperations {
+		if samples := job.Counters[operation].Samples; samples != 0 {
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, float64(samples), "operation", operation))
+		}
 	}
-	var jobList []lustreJobsMetric
+	return metricList
+}
+
+func convertJobStats(jobs []lustre.JobStats, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric) {
 	for _, job := range jobs {
-		jobID, err := getJobNum(job)
-		if err != nil {
-			log.Error(err)
+		if job.JobID == "" {
+			log.Errorf("No valid jobid found for job with snapshot_time %v", job.SnapshotTime)
 			continue
 		}
+		var jobList []lustreStatsMetric
 		if hasMultipleVals {
-			jobList, err = getJobStatsOperationMetrics(job, jobID, promName, helpText)
+			jobList = getJobStatsOperationMetrics(job, promName, helpText)
 		} else {
-			jobList, err = getJobStatsIOMetrics(job, jobID, promName, helpText)
-		}
-		if err != nil {
-			return nil, err
+			jobList = getJobStatsIOMetrics(job, promName, helpText)
 		}
-		if jobList != nil {
-			metricList = append(metricList, jobList...)
+		for _, metric := range jobList {
+			metric.timestamp = job.SnapshotTime
+			metricList = append(metricList, lustreJobsMetric{jobID: job.JobID, lustreStatsMetric: metric})
 		}
 	}
-	return metricList, nil
+	return metricList
 }
 
-func (s *lustreProcFsSource) parseJobStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, float64, string, string)) (err error) {
+func (s *lustreProcFsSource) parseJobStats(telemetry *fileTelemetry, nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, float64, string, string, time.Time)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
-	jobStatsBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	jobStatsBytes, err := telemetry.readFile(path)
 	if err != nil {
 		return err
 	}
-
-	jobStatsFile := string(jobStatsBytes[:])
-
-	metricList, err := parseJobStatsText(jobStatsFile, promName, helpText, hasMultipleVals)
+	jobs, err := lustre.ParseJobStats(bytes.NewReader(jobStatsBytes))
 	if err != nil {
 		return err
 	}
 
-	for _, item := range metricList {
-		handler(nodeType, item.jobID, nodeName, item.lustreStatsMetric.title, item.lustreStatsMetric.help, item.lustreStatsMetric.value, item.lustreStatsMetric.extraLabel, item.lustreStatsMetric.extraLabelValue)
+	for _, item := range convertJobStats(jobs, promName, helpText, hasMultipleVals) {
+		handler(nodeType, item.jobID, nodeName, item.lustreStatsMetric.title, item.lustreStatsMetric.help, item.lustreStatsMetric.value, item.lustreStatsMetric.extraLabel, item.lustreStatsMetric.extraLabelValue, item.lustreStatsMetric.timestamp)
 	}
 	return nil
 }
 
-func (s *lustreProcFsSource) parseBRWStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, string, float64, string, string)) (err error) {
+// brwHistogramNames maps the help texts of the 'brw_stats' and 'rpc_stats' metrics to the names of their histograms.
+var brwHistogramNames = map[string]string{
+	pagesPerBlockRWHelp:    "pages per bulk r/w",
+	discontiguousPagesHelp: "discontiguous pages",
+	diskIOsInFlightHelp:    "disk I/Os in flight",
+	ioTimeHelp:             "I/O time",
+	diskIOSizeHelp:         "disk I/O size",
+	pagesPerRPCHelp:        "pages per rpc",
+	rpcsInFlightHelp:       "rpcs in flight",
+	offsetHelp:             "offset",
+	modifyRPCsInFlightHelp: "rpcs in flight",
+}
+
+// findBRWHistogram returns the first histogram of the metric with the given help text, or nil if there is none.
+func findBRWHistogram(stats lustre.BRWStats, helpText string) *lustre.BRWHistogram {
+	for i, histogram := range stats.Histograms {
+		if !strings.HasPrefix(histogram.Name, brwHistogramNames[helpText]) {
+			continue
+		}
+		// The modify RPCs in flight of the 'rpc_stats' file are listed below a 'modify' column header
+		isModify := len(histogram.Operations) == 1 && histogram.Operations[0] == "modify"
+		if helpText == modifyRPCsInFlightHelp && !isModify {
+			continue
+		}
+		return &stats.Histograms[i]
+	}
+	return nil
+}
+
+func convertBRWStats(stats lustre.BRWStats, helpText string) (metricList []lustreBRWMetric) {
+	histogram := findBRWHistogram(stats, helpText)
+	if histogram == nil {
+		return nil
+	}
+	operations := []string{"read", "write"}
+	if helpText == modifyRPCsInFlightHelp {
+		operations = []string{"modify"}
+	}
+	for _, bucket := range histogram.Buckets {
+		for i, count := range bucket.Counts {
+			if i >= len(operations) {
+				break
+			}
+			metricList = append(metricList, lustreBRWMetric{size: bucket.Size, operation: operations[i], value: strconv.FormatUint(count, 10)})
+		}
+	}
+	return metricList
+}
+
+func (s *lustreProcFsSource) parseBRWStats(telemetry *fileTelemetry, nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, string, float64, string, string, time.Time)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
-	metricBlocks := map[string]string{
-		pagesPerBlockRWHelp:    "pages per bulk r/w",
-		discontiguousPagesHelp: "discontiguous pages",
-		diskIOsInFlightHelp:    "disk I/Os in flight",
-		ioTimeHelp:             "I/O time",
-		diskIOSizeHelp:         "disk I/O size",
-		pagesPerRPCHelp:        "pages per rpc",
-		rpcsInFlightHelp:       "rpcs in flight",
-		offsetHelp:             "offset",
-	}
-	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	statsFileBytes, err := telemetry.readFile(path)
 	if err != nil {
 		return err
 	}
-	statsFile := string(statsFileBytes[:])
-	block := regexCaptureString("(?ms:^"+metricBlocks[helpText]+".*?(\n\n|\\z))", statsFile)
-	metricList, err := splitBRWStats(block)
+	stats, err := lustre.ParseBRWStats(bytes.NewReader(statsFileBytes))
 	if err != nil {
 		return err
 	}
@@ -707,24 +909,106 @@ func (s *lustreProcFsSource) parseBRWStats(nodeType string, metricType string, p
 		pathElements := strings.Split(path, "/")
 		extraLabelValue = pathElements[len(pathElements)-3]
 	}
+	for _, item := range convertBRWStats(stats, helpText) {
+		value, err := strconv.ParseFloat(item.value, 64)
+		if err != nil {
+			return err
+		}
+		handler(nodeType, item.operation, convertToBytes(item.size), nodeName, promName, helpText, value, extraLabel, extraLabelValue, stats.SnapshotTime)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseRenameStats(telemetry *fileTelemetry, nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, string, string, float64)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	statsFileBytes, err := telemetry.readFile(path)
+	if err != nil {
+		return err
+	}
+	metricList, err := splitRenameStats(string(statsFileBytes[:]))
+	if err != nil {
+		return err
+	}
+	for _, item := range metricList {
+		value, err := strconv.ParseFloat(item.value, 64)
+		if err != nil {
+			return err
+		}
+		handler(nodeType, nodeName, item.operation, item.size, promName, helpText, value)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseExtentsStats(telemetry *fileTelemetry, nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, string, string, string, string, float64)) (err error) {
+	filename, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	statsFileBytes, err := telemetry.readFile(path)
+	if err != nil {
+		return err
+	}
+	statsFile := string(statsFileBytes[:])
+	var metricList []lustreExtentsMetric
+	if filename == offsetStats {
+		metricList, err = splitOffsetStats(statsFile)
+	} else {
+		metricList, err = splitExtentsStats(statsFile)
+	}
+	if err != nil {
+		return err
+	}
+	commands := map[string]string{}
 	for _, item := range metricList {
 		value, err := strconv.ParseFloat(item.value, 64)
 		if err != nil {
 			return err
 		}
-		handler(nodeType, item.operation, convertToBytes(item.size), nodeName, promName, helpText, value, extraLabel, extraLabelValue)
+		command := ""
+		if item.pid != "" {
+			if _, exists := commands[item.pid]; !exists {
+				commands[item.pid] = getProcessCommand(s.config.Paths.Proc, item.pid)
+			}
+			command = commands[item.pid]
+		}
+		handler(nodeType, nodeName, item.operation, item.size, item.pid, command, promName, helpText, value)
 	}
 	return nil
 }
 
-func (s *lustreProcFsSource) parseFile(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, float64, string, string)) (err error) {
+// getProcessCommand returns the command name of the given process or an empty string if it already exited.
+func getProcessCommand(procPath string, pid string) string {
+	comm, err := ioutil.ReadFile(filepath.Join(procPath, pid, "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(comm))
+}
+
+// preallocStatusName returns the name of the given OSP precreation status or the error code if it is unknown.
+func preallocStatusName(status string) string {
+	status = strings.TrimSpace(status)
+	code, err := strconv.Atoi(status)
+	if err != nil {
+		return status
+	}
+	if name, exists := preallocStatusNames[code]; exists {
+		return name
+	}
+	return status
+}
+
+func (s *lustreProcFsSource) parseFile(telemetry *fileTelemetry, nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, float64, string, string, time.Time)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
 	switch metricType {
 	case single:
-		value, err := ioutil.ReadFile(filepath.Clean(path))
+		value, err := telemetry.readFile(path)
 		if err != nil {
 			return err
 		}
@@ -732,15 +1016,50 @@ func (s *lustreProcFsSource) parseFile(nodeType string, metricType string, path
 		if err != nil {
 			return err
 		}
-		handler(nodeType, nodeName, promName, helpText, convertedValue, "", "")
-	case stats, mdStats, encryptPagePools:
-		metricList, err := parseStatsFile(helpText, promName, path, hasMultipleVals)
+		handler(nodeType, nodeName, promName, helpText, convertedValue, "", "", time.Time{})
+	case preallocStatus:
+		value, err := telemetry.readFile(path)
+		if err != nil {
+			return err
+		}
+		handler(nodeType, nodeName, promName, helpText, 1, "status", preallocStatusName(string(value)), time.Time{})
+	case targetObd:
+		targetObdBytes, err := telemetry.readFile(path)
+		if err != nil {
+			return err
+		}
+		metricList, err := splitTargetObd(string(targetObdBytes[:]), promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue, metric.timestamp)
+		}
+	case stats, mdStats:
+		statsFileBytes, err := telemetry.readFile(path)
+		if err != nil {
+			return err
+		}
+		statsFile, err := lustre.ParseStatsFile(bytes.NewReader(statsFileBytes))
+		if err != nil {
+			return err
+		}
+
+		for _, metric := range convertStatsFile(statsFile, promName, helpText, hasMultipleVals) {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue, metric.timestamp)
+		}
+	case encryptPagePools:
+		statsFileBytes, err := telemetry.readFile(path)
+		if err != nil {
+			return err
+		}
+		metricList, err := getEncryptPagePoolsMetrics(string(statsFileBytes[:]), promName, helpText)
 		if err != nil {
 			return err
 		}
 
 		for _, metric := range metricList {
-			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue, metric.timestamp)
 		}
 	}
 	return nil
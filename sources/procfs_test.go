@@ -14,72 +14,19 @@
 package sources
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
-)
-
-func TestGetJobNum(t *testing.T) {
-
-	tests := map[string]string{
-		"job_id: 1234":                      "1234",
-		"job_id: ABCD":                      "ABCD",
-		"job_id:  abc .0123 .-_+ AB.1000  ": "abc .0123 .-_+ AB.1000",
-		"job_id:            kworker/86:1.0": "kworker/86:1.0",
-		"job_id: (ostnamed.0)":              "(ostnamed.0)",
-	}
-
-	for testString, expected := range tests {
-		jobID, err := getJobNum(testString)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if jobID != expected {
-			t.Fatalf("Received an unexpected jobid. Expected: %s, Got: %s", expected, jobID)
-		}
-	}
-
-	_, err := getJobNum("")
-	if err == nil {
-		t.Fatal("An error was expected for an empty jobid, but not received")
-	}
-
-	testJobBlock := `- job_id:
-  snapshot_time:   1493326943
-  read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
-  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
-  getattr:         { samples:           1, unit:  reqs }
-  setattr:         { samples:           2, unit:  reqs }
-  punch:           { samples:           3, unit:  reqs }
-  sync:            { samples:           4, unit:  reqs }
-  destroy:         { samples:           5, unit:  reqs }
-  create:          { samples:           6, unit:  reqs }
-  statfs:          { samples:           7, unit:  reqs }
-  get_info:        { samples:           8, unit:  reqs }
-  set_info:        { samples:           9, unit:  reqs }
-  quotactl:        { samples:           10, unit:  reqs }
-  - job_id: 28
-  snapshot_time:   1493326943
-  read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
-  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
-  getattr:         { samples:           1, unit:  reqs }
-  setattr:         { samples:           2, unit:  reqs }
-  punch:           { samples:           3, unit:  reqs }
-  sync:            { samples:           4, unit:  reqs }
-  destroy:         { samples:           5, unit:  reqs }
-  create:          { samples:           6, unit:  reqs }
-  statfs:          { samples:           7, unit:  reqs }
-  get_info:        { samples:           8, unit:  reqs }
-  set_info:        { samples:           9, unit:  reqs }
-  quotactl:        { samples:           10, unit:  reqs }`
-	expectedJobId := ""
+	"time"
 
-	jobID, err := getJobNum(testJobBlock)
-	if err == nil {
-		t.Fatal("An error was expected for an empty jobid, but not received")
-	}
-	if jobID != expectedJobId {
-		t.Fatalf("Expected empty jobid. Got: %s", jobID)
-	}
-}
+	"github.com/GSI-HPC/lustre_exporter/lustre"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
 
 func TestGetJobStats(t *testing.T) {
 	testJobBlock := `- job_id:          29
@@ -96,16 +43,17 @@ func TestGetJobStats(t *testing.T) {
   get_info:        { samples:           8, unit:  reqs }
   set_info:        { samples:           9, unit:  reqs }
   quotactl:        { samples:           10, unit:  reqs }`
-	testJobID := "29"
+	jobs, err := lustre.ParseJobStats(strings.NewReader(testJobBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	job := jobs[0]
 
 	testPromName := "job_read_bytes_total"
 	testHelpText := readTotalHelp
 	expected := float64(132120576)
 
-	metricList, err := getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList := getJobStatsIOMetrics(job, testPromName, testHelpText)
 	if l := len(metricList); l != 1 {
 		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 1, l)
 	}
@@ -123,10 +71,7 @@ func TestGetJobStats(t *testing.T) {
 	testHelpText = writeTotalHelp
 	expected = float64(274726912)
 
-	metricList, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsIOMetrics(job, testPromName, testHelpText)
 	if l := len(metricList); l != 1 {
 		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 1, l)
 	}
@@ -143,10 +88,7 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "job_stats_total"
 	testHelpText = jobStatsHelp
 
-	metricList, err = getJobStatsOperationMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsOperationMetrics(job, testPromName, testHelpText)
 	if l := len(metricList); l != 10 {
 		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 1, l)
 	}
@@ -163,10 +105,7 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "dne"
 	testHelpText = "Help for DNE"
 
-	metricList, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsIOMetrics(job, testPromName, testHelpText)
 	if l := len(metricList); l != 0 {
 		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 0, l)
 	}
@@ -185,15 +124,16 @@ func TestGetJobStats(t *testing.T) {
   get_info:        { samples:           0, unit:  reqs }
   set_info:        { samples:           0, unit:  reqs }
   quotactl:        { samples:           6, unit:  reqs }`
-	testJobID = "30"
+	jobs, err = lustre.ParseJobStats(strings.NewReader(testJobBlock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	job = jobs[0]
 
 	testPromName = "job_read_bytes_total"
 	testHelpText = readTotalHelp
 
-	metricList, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsIOMetrics(job, testPromName, testHelpText)
 	if metricList != nil {
 		t.Fatal("Retrieved metric object. Expected nil, since 0 values should be dropped.")
 	}
@@ -201,10 +141,7 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "job_write_bytes_total"
 	testHelpText = writeTotalHelp
 
-	metricList, err = getJobStatsIOMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsIOMetrics(job, testPromName, testHelpText)
 	if metricList != nil {
 		t.Fatal("Retrieved metric object. Expected nil, since 0 values should be dropped.")
 	}
@@ -212,10 +149,7 @@ func TestGetJobStats(t *testing.T) {
 	testPromName = "job_stats_total"
 	testHelpText = jobStatsHelp
 
-	metricList, err = getJobStatsOperationMetrics(testJobBlock, testJobID, testPromName, testHelpText)
-	if err != nil {
-		t.Fatal(err)
-	}
+	metricList = getJobStatsOperationMetrics(job, testPromName, testHelpText)
 	if l := len(metricList); l != 6 {
 		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 6, l)
 	}
@@ -230,52 +164,208 @@ func TestGetJobStats(t *testing.T) {
 	}
 }
 
-func TestJobStatBlockIds(t *testing.T) {
-
-	testJobBlocks := `- job_id: 67
-	snapshot_time:   1493326943
-	read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
-	write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
-	getattr:         { samples:           1, unit:  reqs }
-	setattr:         { samples:           2, unit:  reqs }
-	punch:           { samples:           3, unit:  reqs }
-	sync:            { samples:           4, unit:  reqs }
-	destroy:         { samples:           5, unit:  reqs }
-	create:          { samples:           6, unit:  reqs }
-	statfs:          { samples:           7, unit:  reqs }
-	get_info:        { samples:           8, unit:  reqs }
-	set_info:        { samples:           9, unit:  reqs }
-	quotactl:        { samples:           10, unit:  reqs }
-	- job_id: 28
-	snapshot_time:   1493326943
-	read_bytes:      { samples:         126, unit: bytes, min: 1048576, max: 1048576, sum:       132120576 }
-	write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }
-	getattr:         { samples:           1, unit:  reqs }
-	setattr:         { samples:           2, unit:  reqs }
-	punch:           { samples:           3, unit:  reqs }
-	sync:            { samples:           4, unit:  reqs }
-	destroy:         { samples:           5, unit:  reqs }
-	create:          { samples:           6, unit:  reqs }
-	statfs:          { samples:           7, unit:  reqs }
-	get_info:        { samples:           8, unit:  reqs }
-	set_info:        { samples:           9, unit:  reqs }
-	quotactl:        { samples:           10, unit:  reqs }`
-
-	expectedJobIds := []string{"67", "28"}
-
-	matchedJobBlocks := regexCaptureJobStats(testJobBlocks)
-
-	if l := len(matchedJobBlocks); l != 2 {
-		t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 2, l)
-	}
-
-	for index, expected := range expectedJobIds {
-		jobId, err := getJobNum(matchedJobBlocks[index])
-		if err != nil {
+func TestSplitExtentsStats(t *testing.T) {
+	testExtentsStats := `snapshot_time:         1510950459.787901292 (secs.nsecs)
+                               read       |                write
+      extents            calls    % cum%   |          calls    % cum%
+
+PID: 11488
+   0K -    4K :             12   60   60   |              3   25   25
+   4K -    8K :              8   40  100   |              9   75  100
+   1M -    2M+:              0    0  100   |              0    0  100`
+	expected := []lustreExtentsMetric{
+		{"11488", "read", "0", "12"},
+		{"11488", "write", "0", "3"},
+		{"11488", "read", "4096", "8"},
+		{"11488", "write", "4096", "9"},
+		{"11488", "read", "1048576", "0"},
+		{"11488", "write", "1048576", "0"},
+	}
+
+	metricList, err := splitExtentsStats(testExtentsStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Retrieved unexpected extents. Expected: %+v, Got: %+v", expected, metricList)
+	}
+
+	metricList, err = splitExtentsStats("disabled\n write anything to this file to activate, then '0' or 'disable' to deactivate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Retrieved extents from a disabled file: %+v", metricList)
+	}
+}
+
+func TestSplitOffsetStats(t *testing.T) {
+	testOffsetStats := `snapshot_time:         1510950459.787901292 (secs.nsecs)
+R/W        PID    RANGE START      RANGE END   SMALLEST EXTENT    LARGEST EXTENT         OFFSET
+  R      11488              0           4096              4096              4096              0
+  W      11488           8192          12288              4095              4096           4096
+  W      11490              0        1048576           1048576           1048576              0
+  W      11491              0           7000              7000              7000              0`
+	expected := []lustreExtentsMetric{
+		{"", "read", "4096", "1"},
+		{"", "write", "2048", "1"},
+		{"", "write", "1048576", "1"},
+		{"", "write", "4096", "1"},
+	}
+
+	metricList, err := splitOffsetStats(testOffsetStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Retrieved unexpected offsets. Expected: %+v, Got: %+v", expected, metricList)
+	}
+}
+
+func TestPreallocStatusName(t *testing.T) {
+	tests := map[string]string{
+		"0\n":  "ok",
+		"-28":  "ENOSPC",
+		"-110": "ETIMEDOUT",
+		"-61":  "-61",
+	}
+	for status, expected := range tests {
+		if name := preallocStatusName(status); name != expected {
+			t.Fatalf("Retrieved an unexpected precreation status. Expected: %s, Got: %s", expected, name)
+		}
+	}
+}
+
+func TestSplitTargetObd(t *testing.T) {
+	testTargetObd := `0: lustrefs-MDT0000_UUID ACTIVE
+1: lustrefs-MDT0001_UUID INACTIVE
+`
+	expected := []lustreStatsMetric{
+		{"lmv_target_active", lmvTargetActiveHelp, 1, "mdt", "lustrefs-MDT0000", time.Time{}},
+		{"lmv_target_active", lmvTargetActiveHelp, 0, "mdt", "lustrefs-MDT0001", time.Time{}},
+	}
+
+	metricList, err := splitTargetObd(testTargetObd, "lmv_target_active", lmvTargetActiveHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Retrieved unexpected targets. Expected: %+v, Got: %+v", expected, metricList)
+	}
+}
+
+func TestSplitRenameStats(t *testing.T) {
+	testRenameStats := `rename_stats:
+- snapshot_time:  1510781853.10473844
+- same_dir:
+      4KB: { samples:       1, pct:  50, cum_pct:  50 }
+      8KB: { samples:       1, pct:  50, cum_pct: 100 }
+- crossdir_src:
+      1MB: { samples:       2, pct: 100, cum_pct: 100 }
+- crossdir_tgt:
+      4KB: { samples:       2, pct: 100, cum_pct: 100 }`
+	expected := []lustreBRWMetric{
+		{"4096", "same_dir", "1"},
+		{"8192", "same_dir", "1"},
+		{"1048576", "crossdir_src", "2"},
+		{"4096", "crossdir_tgt", "2"},
+	}
+
+	metricList, err := splitRenameStats(testRenameStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Retrieved unexpected renames. Expected: %+v, Got: %+v", expected, metricList)
+	}
+}
+
+func TestClientPerProcessTemplate(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		var s lustreProcFsSource
+		s.config.ClientPerProcess = enabled
+		s.generateClientMetricTemplates(core)
+		found := false
+		for _, metric := range s.lustreProcMetrics {
+			if metric.filename == extentsStatsPerProcess {
+				found = true
+			}
+		}
+		if found != enabled {
+			t.Fatalf("Unexpected per-process template with per-process statistics enabled: %t", enabled)
+		}
+	}
+}
+
+func TestConvertStatsFileOperations(t *testing.T) {
+	testStats := `snapshot_time             1510950459.782265624 secs.nsecs
+close                     11 samples [reqs]
+intent_lock               329 samples [reqs]
+`
+	statsFile, err := lustre.ParseStatsFile(strings.NewReader(testStats))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client side metadata operations are only exported from the 'md_stats' files of the MDCs
+	tests := map[string][]string{
+		statsHelp:   {"close"},
+		mdStatsHelp: {"close", "intent_lock"},
+	}
+	for helpText, expected := range tests {
+		var operations []string
+		for _, metric := range convertStatsFile(statsFile, "stats_total", helpText, true) {
+			operations = append(operations, metric.extraLabelValue)
+		}
+		if !reflect.DeepEqual(operations, expected) {
+			t.Fatalf("Retrieved unexpected operations for %q. Expected: %v, Got: %v", helpText, expected, operations)
+		}
+	}
+}
+
+func TestOffsetStatsGauge(t *testing.T) {
+	dir, err := ioutil.TempDir("", "offset")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fs/lustre/llite/lustrefs-ffff88105db50000/offset_stats")
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	offsetStats := `snapshot_time:         1510950459.787901292 (secs.nsecs)
+R/W        PID    RANGE START      RANGE END   SMALLEST EXTENT    LARGEST EXTENT         OFFSET
+  R      11488              0           4096              4096              4096              0
+`
+	if err := ioutil.WriteFile(path, []byte(offsetStats), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{Client: extended}
+	config.Paths = DefaultPaths()
+	config.Paths.Proc = dir
+	ch := make(chan prometheus.Metric, 100)
+	if err := newLustreProcFsSource(config).Update(context.Background(), ch); err != nil {
+		t.Fatal(err)
+	}
+	close(ch)
+
+	// The offsets are the most recent ranges, which are reset on write, so they are no counter
+	found := false
+	for metric := range ch {
+		var m dto.Metric
+		if err := metric.Write(&m); err != nil {
 			t.Fatal(err)
 		}
-		if jobId != expected {
-			t.Fatalf("Received an unexpected jobId. Expected: %s, Got: %s", expected, jobId)
+		if !strings.Contains(metric.Desc().String(), `"lustre_offset_extents"`) {
+			continue
 		}
+		found = true
+		if m.Gauge == nil || m.Gauge.GetValue() != 1 {
+			t.Fatalf("Retrieved an unexpected offset extents metric. Expected: gauge 1, Got: %s", m.String())
+		}
+	}
+	if !found {
+		t.Fatal("Expected the offset extents to be exported")
 	}
 }
@@ -0,0 +1,53 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// Roles a node can take within a Lustre filesystem
+const (
+	RoleOST    string = "ost"
+	RoleMDT    string = "mdt"
+	RoleMGS    string = "mgs"
+	RoleQMT    string = "qmt"
+	RoleClient string = "client"
+)
+
+// nodeRoleDevices maps the roles to the devices present on a node with the role.
+var nodeRoleDevices = []struct {
+	role    string
+	pattern string
+}{
+	{RoleOST, "obdfilter/*-OST*"},
+	{RoleMDT, "mdt/*-MDT*"},
+	{RoleMGS, "mgs/MGS"},
+	{RoleQMT, "qmt/*-QMT*"},
+	{RoleClient, "llite/*"},
+}
+
+// DetectNodeRoles returns the roles of the node based on the Lustre devices present.
+func DetectNodeRoles(paths Paths) []string {
+	layout := detectLustreLayout(paths)
+	basePaths := append(append([]string{}, layout.procfs...), layout.sysfs...)
+
+	var roles []string
+	for _, device := range nodeRoleDevices {
+		paths, err := globPaths(basePaths, device.pattern)
+		if err != nil {
+			log.Debugf("Unable to detect role %q: %s", device.role, err)
+			continue
+		}
+		if paths != nil {
+			roles = append(roles, device.role)
+		}
+	}
+	return roles
+}
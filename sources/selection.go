@@ -0,0 +1,140 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+// MetricRule enables or disables the metric families matching all of its selectors.
+// The selectors are glob patterns, an empty selector matches any value.
+type MetricRule struct {
+	// Collector matches the collector creating the metric, e.g. "ost"
+	Collector string
+	// Metric matches the metric name with or without the namespace, e.g. "job_*" or "lustre_job_*"
+	Metric string
+	// File matches the source file name or its path pattern, e.g. "job_stats" or "obdfilter/*-OST*/job_stats"
+	File    string
+	Enabled bool
+}
+
+// Validate checks that the rule has any selector, that all selectors are valid glob patterns
+// and that the rule matches any metric family of the sources, so a misspelled selector is not silently ignored.
+func (r MetricRule) Validate() error {
+	if r.Collector == "" && r.Metric == "" && r.File == "" {
+		return fmt.Errorf("rule without a collector, metric or file selector")
+	}
+	for _, pattern := range []string{r.Collector, r.Metric, r.File} {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %s", pattern, err)
+		}
+	}
+	for _, family := range knownMetricFamilies() {
+		if r.matches(family.collector, family.filePattern, family.promName) {
+			return nil
+		}
+	}
+	return fmt.Errorf("rule matches no metric of the collectors")
+}
+
+// metricFamily identifies a metric family selected by the rules.
+type metricFamily struct {
+	collector   string
+	filePattern string
+	promName    string
+}
+
+// knownMetricFamilies returns the metric families of all collectors of the sources
+// by recording the ones considered by the constructors with all collectors enabled.
+func knownMetricFamilies() []metricFamily {
+	var families []metricFamily
+	config := DefaultConfig()
+	config.ClientPerProcess = true
+	config.CommandRunner = nil
+	config.recordFamily = func(collector string, filePattern string, promName string) {
+		families = append(families, metricFamily{collector, filePattern, promName})
+	}
+	for _, factory := range Factories {
+		factory(config)
+	}
+	return families
+}
+
+func (r MetricRule) matches(collector string, filePattern string, promName string) bool {
+	return globMatch(r.Collector, collector) &&
+		(globMatch(r.Metric, promName) || globMatch(r.Metric, Namespace+"_"+promName)) &&
+		(globMatch(r.File, path.Base(filePattern)) || globMatch(r.File, filePattern))
+}
+
+func globMatch(pattern string, name string) bool {
+	if pattern == "" {
+		return true
+	}
+	matched, _ := path.Match(pattern, name)
+	return matched
+}
+
+// metricSelected returns whether the metric family from the file pattern is collected
+// by the collector with the given level, see Config.MetricRules.
+func (c Config) metricSelected(filter string, collector string, filePattern string, promName string, priorityLevel string) bool {
+	if c.recordFamily != nil {
+		c.recordFamily(collector, filePattern, promName)
+	}
+	selected := filter == extended || priorityLevel == core
+	for _, rule := range c.MetricRules {
+		if rule.matches(collector, filePattern, promName) {
+			selected = rule.Enabled
+		}
+	}
+	return selected
+}
+
+// templateSelected returns whether the metric template below the path is collected, see metricSelected.
+func (c Config) templateSelected(filter string, collector string, templatePath string, item lustreHelpStruct) bool {
+	return c.metricSelected(filter, collector, path.Join(templatePath, item.filename), item.promName, item.priorityLevel)
+}
+
+// JobStatsCollector is the name of the job statistics of the OST and MDT collectors within a Selection.
+// They are selected separately from their collector, since they are typically scraped in a different interval.
+const JobStatsCollector = "jobstats"
+
+// Selection restricts an update to the metrics of the selected collectors, a nil selection selects all of them.
+type Selection map[string]bool
+
+func (s Selection) selected(collector string) bool {
+	return s == nil || s[collector]
+}
+
+// selectionCollector returns the name of the collector of the metric template within a Selection.
+func selectionCollector(collector string, filename string) string {
+	if filename == "job_stats" {
+		return JobStatsCollector
+	}
+	return collector
+}
+
+// templateCollectors returns the sorted names of the collectors of the metric templates within a Selection.
+func templateCollectors(metrics []lustreProcMetric) []string {
+	var collectors []string
+	for _, metric := range metrics {
+		collectors = appendCollector(collectors, selectionCollector(metric.source, metric.filename))
+	}
+	return collectors
+}
+
+// appendCollector inserts the collector into the sorted list unless it is already contained.
+func appendCollector(collectors []string, collector string) []string {
+	i := sort.SearchStrings(collectors, collector)
+	if i < len(collectors) && collectors[i] == collector {
+		return collectors
+	}
+	return append(collectors[:i], append([]string{collector}, collectors[i:]...)...)
+}
@@ -0,0 +1,108 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMetricSelected(t *testing.T) {
+	config := Config{MetricRules: []MetricRule{
+		{Collector: "generic", File: encryptPagePools, Enabled: false},
+		{Collector: "ost", File: "obdfilter/*/job_stats", Enabled: false},
+		{Metric: "lustre_job_read_bytes_total", Enabled: true},
+		{Collector: "mdt", Metric: "exports_*", Enabled: false},
+	}}
+
+	tests := []struct {
+		filter        string
+		collector     string
+		filePattern   string
+		promName      string
+		priorityLevel string
+		expected      bool
+	}{
+		{extended, "ost", "obdfilter/*-OST*/brw_size", "brw_size_megabytes", extended, true},
+		{core, "ost", "obdfilter/*-OST*/brw_size", "brw_size_megabytes", extended, false},
+		{extended, "generic", "sptlrpc/" + encryptPagePools, "physical_pages", extended, false},
+		{extended, "ost", "sptlrpc/" + encryptPagePools, "physical_pages", extended, true},
+		{extended, "ost", "obdfilter/*-OST*/job_stats", "job_write_samples_total", core, false},
+		{extended, "ost", "obdfilter/*-OST*/job_stats", "job_read_bytes_total", core, true},
+		{extended, "mdt", "mdt/*/job_stats", "job_write_samples_total", core, true},
+		{extended, "mdt", "mdt/*/num_exports", "exports_total", core, false},
+	}
+	for _, test := range tests {
+		if selected := config.metricSelected(test.filter, test.collector, test.filePattern, test.promName, test.priorityLevel); selected != test.expected {
+			t.Fatalf("Unexpected selection of %s from %s by the %s collector. Expected: %t, Got: %t", test.promName, test.filePattern, test.collector, test.expected, selected)
+		}
+	}
+}
+
+func TestValidateMetricRule(t *testing.T) {
+	if err := (MetricRule{Metric: "job_*"}).Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := (MetricRule{}).Validate(); err == nil {
+		t.Fatal("Expected an error for a rule without selectors")
+	}
+	if err := (MetricRule{File: "obdfilter/[*"}).Validate(); err == nil {
+		t.Fatal("Expected an error for an invalid pattern")
+	}
+	for _, rule := range []MetricRule{
+		{Collector: "generic", File: encryptPagePools},
+		{Collector: "mdt", Metric: "changelog_user_index"},
+		{Collector: "client", File: extentsStatsPerProcess},
+		{Collector: "lnet", Metric: "lustre_lnet_memory_used_bytes"},
+	} {
+		if err := rule.Validate(); err != nil {
+			t.Fatalf("Unexpected error for the rule %+v: %s", rule, err)
+		}
+	}
+	for _, rule := range []MetricRule{
+		{Collector: "ost", File: encryptPagePools},
+		{Collector: "oss"},
+		{Metric: "job_stats"},
+	} {
+		if err := rule.Validate(); err == nil {
+			t.Fatalf("Expected an error for the rule %+v matching no metric", rule)
+		}
+	}
+}
+
+func TestTemplateCollectors(t *testing.T) {
+	metrics := []lustreProcMetric{
+		{filename: "num_exports", source: "ost"},
+		{filename: "job_stats", source: "ost"},
+		{filename: "job_stats", source: "mdt"},
+		{filename: "health_check", source: "health"},
+		{filename: "degraded", source: "ost"},
+	}
+	expected := []string{"health", JobStatsCollector, "ost"}
+	if collectors := templateCollectors(metrics); !reflect.DeepEqual(collectors, expected) {
+		t.Fatalf("Retrieved unexpected collectors. Expected: %v, Got: %v", expected, collectors)
+	}
+	if !(Selection)(nil).selected("ost") || (Selection{"mdt": true}).selected("ost") {
+		t.Fatal("Unexpected selection of the ost collector")
+	}
+}
+
+func TestMetricRuleTemplates(t *testing.T) {
+	config := DefaultConfig()
+	for _, rules := range [][]MetricRule{nil, {{Collector: "generic", File: encryptPagePools, Enabled: false}}} {
+		config.MetricRules = rules
+		found := false
+		for _, metric := range newLustreProcFsSource(config).(*lustreProcFsSource).lustreProcMetrics {
+			found = found || metric.filename == encryptPagePools
+		}
+		if found != (rules == nil) {
+			t.Fatalf("Unexpected templates of %s with the rules %+v", encryptPagePools, rules)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// minSnapshotTime is the earliest snapshot_time accepted as a timestamp,
+// older Lustre versions report the time since boot instead of the time since the epoch in some files.
+var minSnapshotTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// withSnapshotTime returns the metric with the snapshot time as its timestamp if SnapshotTimestamps is enabled
+// and the snapshot time is valid.
+func (c Config) withSnapshotTime(metric prometheus.Metric, snapshotTime time.Time) prometheus.Metric {
+	if !c.SnapshotTimestamps || metric == nil || snapshotTime.Before(minSnapshotTime) {
+		return metric
+	}
+	return prometheus.NewMetricWithTimestamp(snapshotTime, metric)
+}
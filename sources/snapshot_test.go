@@ -0,0 +1,78 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GSI-HPC/lustre_exporter/lustre"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestWithSnapshotTime(t *testing.T) {
+	desc := prometheus.NewDesc("test", "test", nil, nil)
+	snapshotTime := time.Unix(1638540802, 0)
+
+	for _, test := range []struct {
+		enabled      bool
+		snapshotTime time.Time
+		expected     int64
+	}{
+		{false, snapshotTime, 0},
+		{true, time.Time{}, 0},
+		{true, time.Unix(4632, 123456000), 0},
+		{true, snapshotTime, 1638540802000},
+	} {
+		config := Config{SnapshotTimestamps: test.enabled}
+		metric := config.withSnapshotTime(prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, 1), test.snapshotTime)
+		var m dto.Metric
+		if err := metric.Write(&m); err != nil {
+			t.Fatal(err)
+		}
+		if timestamp := m.GetTimestampMs(); timestamp != test.expected {
+			t.Fatalf("Retrieved an unexpected timestamp. Expected: %d, Got: %d", test.expected, timestamp)
+		}
+	}
+}
+
+func TestJobStatsTimes(t *testing.T) {
+	testJobStats := `job_stats:
+- job_id:          29
+  snapshot_time:   1638540802.123456789 secs.nsecs
+  start_time:      1638540000.000000000 secs.nsecs
+  write_bytes:     { samples:         262, unit: bytes, min: 1048576, max: 1048576, sum:       274726912 }`
+
+	for _, test := range []struct {
+		promName string
+		helpText string
+		expected float64
+	}{
+		{"job_start_time_seconds", jobStartTimeHelp, 1638540000},
+		{"job_last_update_time_seconds", jobLastUpdateHelp, 1638540802.123456789},
+		{"job_write_bytes_total", writeTotalHelp, 274726912},
+	} {
+		jobs, err := lustre.ParseJobStats(strings.NewReader(testJobStats))
+		if err != nil {
+			t.Fatal(err)
+		}
+		metricList := convertJobStats(jobs, test.promName, test.helpText, false)
+		if l := len(metricList); l != 1 {
+			t.Fatalf("Retrieved an unexpected number of items. Expected: %d, Got: %d", 1, l)
+		}
+		if metricList[0].value != test.expected {
+			t.Fatalf("Retrieved an unexpected value of %s. Expected: %f, Got: %f", test.promName, test.expected, metricList[0].value)
+		}
+		if expected := time.Unix(1638540802, 123456789); !metricList[0].timestamp.Equal(expected) {
+			t.Fatalf("Retrieved an unexpected timestamp of %s. Expected: %v, Got: %v", test.promName, expected, metricList[0].timestamp)
+		}
+	}
+}
@@ -14,70 +14,37 @@
 package sources
 
 import (
+	"context"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-// ProcLocation is the source to pull proc files from. By default, use the '/proc' directory on the local node,
-// but for testing purposes, specify 'proc' (without the leading '/') for the local files.
-var ProcLocation = "/proc"
-
-// SysLocation is the source to pull sys files from.
-var SysLocation = "/sys"
-
-// If LctlCommandMode is true it enables execution of lctl command which is meant to be executed on a Lustre client node.
-// With false a local file is processed with test data.
-var LctlCommandMode = true
-
 //Namespace defines the namespace shared by all Lustre metrics.
 const Namespace = "lustre"
 
-//Factories contains the list of all sources.
-var Factories = make(map[string]func() LustreSource)
+//Factories contains the list of all sources, which are created with the given config.
+var Factories = make(map[string]func(config Config) LustreSource)
 
 //LustreSource is the interface that each source implements.
 type LustreSource interface {
-	Update(ch chan<- prometheus.Metric) (err error)
+	// Update sends the metrics of the source, it stops reading further files once the context is done.
+	Update(ctx context.Context, ch chan<- prometheus.Metric) (err error)
+	// UpdateSelected updates the metrics of the selected collectors only.
+	UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection Selection) (err error)
+	Describe(ch chan<- *prometheus.Desc)
+	// Collectors returns the names of the collectors the source creates metrics for, see Selection.
+	Collectors() []string
 }
 
-func counterMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
-	return prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(Namespace, "", name),
-			helpText,
-			labels,
-			nil,
-		),
-		prometheus.CounterValue,
-		value,
-		labelValues...,
-	)
+func (l *descriptorList) counterMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
+	return l.newConstMetric(prometheus.CounterValue, labels, labelValues, name, helpText, value)
 }
 
-func gaugeMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
-	return prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(Namespace, "", name),
-			helpText,
-			labels,
-			nil,
-		),
-		prometheus.GaugeValue,
-		value,
-		labelValues...,
-	)
+func (l *descriptorList) gaugeMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
+	return l.newConstMetric(prometheus.GaugeValue, labels, labelValues, name, helpText, value)
 }
 
 //lint:ignore U1000 Ignore unused function for later use
-func untypedMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
-	return prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(Namespace, "", name),
-			helpText,
-			labels,
-			nil,
-		),
-		prometheus.UntypedValue,
-		value,
-		labelValues...,
-	)
+func (l *descriptorList) untypedMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
+	return l.newConstMetric(prometheus.UntypedValue, labels, labelValues, name, helpText, value)
 }
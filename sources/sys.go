@@ -14,7 +14,8 @@
 package sources
 
 import (
-	"io/ioutil"
+	"context"
+	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -40,16 +41,15 @@ const (
 	stats  string = "stats"
 )
 
-// LnetEnabled specified whether LNET metrics should be collected
-var LnetEnabled string
-
 func init() {
 	Factories["sys"] = newLustreSysSource
 }
 
 type lustreSysSource struct {
+	descriptorList
 	lustreProcMetrics []lustreProcMetric
-	basePath          string
+	config            Config
+	layouts           layoutCache
 }
 
 func (s *lustreSysSource) generateLNETTemplates(filter string) {
@@ -82,7 +82,7 @@ func (s *lustreSysSource) generateLNETTemplates(filter string) {
 
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "lnet", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "lnet", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -90,38 +90,67 @@ func (s *lustreSysSource) generateLNETTemplates(filter string) {
 	}
 }
 
-func newLustreSysSource() LustreSource {
+func newLustreSysSource(config Config) LustreSource {
 	var l lustreSysSource
-	l.basePath = filepath.Join(SysLocation, "kernel/debug")
-	if LnetEnabled != disabled {
-		l.generateLNETTemplates(LnetEnabled)
+	l.config = config
+	l.telemetry = config.Telemetry
+	if config.Lnet != disabled {
+		l.generateLNETTemplates(config.Lnet)
+	}
+	for _, metric := range l.lustreProcMetrics {
+		l.addDescriptor(metric.promName, metric.helpText, withTargetLabels()...)
 	}
 	return &l
 }
 
-func (s *lustreSysSource) Update(ch chan<- prometheus.Metric) (err error) {
+func (s *lustreSysSource) Update(ctx context.Context, ch chan<- prometheus.Metric) (err error) {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+// Collectors returns the names of the collectors the source creates metrics for.
+func (s *lustreSysSource) Collectors() []string {
+	return templateCollectors(s.lustreProcMetrics)
+}
+
+func (s *lustreSysSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection Selection) (err error) {
 	var metricType string
 
+	_, layout := s.layouts.detect(s.config.Paths)
+	var errs collectionErrors
 	for _, metric := range s.lustreProcMetrics {
+		if !selection.selected(selectionCollector(metric.source, metric.filename)) {
+			continue
+		}
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
 
-		path, err := filepath.Abs(filepath.Join(s.basePath, metric.path, metric.filename))
+		pattern := filepath.Join(metric.path, metric.filename)
+		telemetry := s.config.Telemetry.newFileTelemetry(metric.source, pattern)
+		path, err := filepath.Abs(findPath(layout.lnet, pattern))
 		if err != nil {
-			return err
+			telemetry.failed()
+			telemetry.done()
+			errs = append(errs, err)
+			continue
 		}
 
 		metricType = single
 		if metric.filename == stats {
 			metricType = stats
 		}
-		err = s.parseFile(metric.source, metricType, path, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
-			ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
+		err = s.parseFile(telemetry, metric.source, metricType, path, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
+			labels, labelValues := targetLabels(nodeType, nodeName, nil)
+			sendMetric(ch, metric.metricFunc(labels, labelValues, name, helpText, value))
 		})
 		if err != nil {
-			return err
+			telemetry.failed()
+			errs = append(errs, fmt.Errorf("%s - %s", path, err))
 		}
-
+		telemetry.done()
 	}
-	return nil
+	return errs.err()
 }
 
 func parseSysStatsFile(helpText string, promName string, statsFile string) (metric lustreStatsMetric, err error) {
@@ -151,14 +180,14 @@ func parseSysStatsFile(helpText string, promName string, statsFile string) (metr
 	return *newLustreStatsMetric(promName, helpText, value, "", ""), nil
 }
 
-func (s *lustreSysSource) parseFile(nodeType string, metricType string, path string, helpText string, promName string, handler func(string, string, string, string, float64)) (err error) {
+func (s *lustreSysSource) parseFile(telemetry *fileTelemetry, nodeType string, metricType string, path string, helpText string, promName string, handler func(string, string, string, string, float64)) (err error) {
 	_, nodeName, err := parseFileElements(path, 0)
 	if err != nil {
 		return err
 	}
 	switch metricType {
 	case single:
-		value, err := ioutil.ReadFile(filepath.Clean(path))
+		value, err := telemetry.readFile(path)
 		if err != nil {
 			return err
 		}
@@ -168,7 +197,7 @@ func (s *lustreSysSource) parseFile(nodeType string, metricType string, path str
 		}
 		handler(nodeType, nodeName, promName, helpText, convertedValue)
 	case stats:
-		statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+		statsFileBytes, err := telemetry.readFile(path)
 		if err != nil {
 			return err
 		}
@@ -181,31 +210,3 @@ func (s *lustreSysSource) parseFile(nodeType string, metricType string, path str
 	}
 	return nil
 }
-
-func (s *lustreSysSource) counterMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
-	return prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(Namespace, "", name),
-			helpText,
-			labels,
-			nil,
-		),
-		prometheus.CounterValue,
-		value,
-		labelValues...,
-	)
-}
-
-func (s *lustreSysSource) gaugeMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
-	return prometheus.MustNewConstMetric(
-		prometheus.NewDesc(
-			prometheus.BuildFQName(Namespace, "", name),
-			helpText,
-			labels,
-			nil,
-		),
-		prometheus.GaugeValue,
-		value,
-		labelValues...,
-	)
-}
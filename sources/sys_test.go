@@ -15,23 +15,24 @@ package sources
 
 import (
 	"testing"
+	"time"
 )
 
 func TestReadStatsFile(t *testing.T) {
 	numParsedMetrics := 0
 	testLNETStatsText := "0 16 0 1911487 1898918 0 0 498100008 543996712 0 0"
 	expectedResults := []lustreStatsMetric{
-		{"allocated", lnetAllocatedHelp, 0, "", ""},
-		{"maximum", lnetMaximumHelp, 16, "", ""},
-		{"errors", lnetErrorsHelp, 0, "", ""},
-		{"send_count", lnetSendCountHelp, 1911487, "", ""},
-		{"receive_count", lnetReceiveCountHelp, 1898918, "", ""},
-		{"route_count", lnetRouteCountHelp, 0, "", ""},
-		{"drop_count", lnetDropCountHelp, 0, "", ""},
-		{"send_length", lnetSendLengthHelp, 498100008, "", ""},
-		{"receive_length", lnetReceiveLengthHelp, 543996712, "", ""},
-		{"route_length", lnetRouteLengthHelp, 0, "", ""},
-		{"drop_length", lnetDropLengthHelp, 0, "", ""},
+		{"allocated", lnetAllocatedHelp, 0, "", "", time.Time{}},
+		{"maximum", lnetMaximumHelp, 16, "", "", time.Time{}},
+		{"errors", lnetErrorsHelp, 0, "", "", time.Time{}},
+		{"send_count", lnetSendCountHelp, 1911487, "", "", time.Time{}},
+		{"receive_count", lnetReceiveCountHelp, 1898918, "", "", time.Time{}},
+		{"route_count", lnetRouteCountHelp, 0, "", "", time.Time{}},
+		{"drop_count", lnetDropCountHelp, 0, "", "", time.Time{}},
+		{"send_length", lnetSendLengthHelp, 498100008, "", "", time.Time{}},
+		{"receive_length", lnetReceiveLengthHelp, 543996712, "", "", time.Time{}},
+		{"route_length", lnetRouteLengthHelp, 0, "", "", time.Time{}},
+		{"drop_length", lnetDropLengthHelp, 0, "", "", time.Time{}},
 	}
 
 	for _, result := range expectedResults {
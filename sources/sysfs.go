@@ -14,7 +14,8 @@
 package sources
 
 import (
-	"io/ioutil"
+	"context"
+	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -28,29 +29,26 @@ const (
 	healthCheckUnhealthy string = "0"
 )
 
-var (
-	// HealthStatusEnabled specifies whether to collect Health metrics
-	HealthStatusEnabled string
-)
-
 func init() {
 	Factories["sysfs"] = newLustreSysFsSource
 }
 
 type LustreSysFsSource struct {
+	descriptorList
 	lustreProcMetrics []lustreProcMetric
-	basePath          string
+	config            Config
+	layouts           layoutCache
 }
 
 func (s *LustreSysFsSource) generateHealthStatusTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"": {
-			{"health_check", "health_check", "Current health status for the indicated instance: " + healthCheckHealthy + " refers to 'healthy', " + healthCheckUnhealthy + " refers to 'unhealthy'", gaugeMetric, false, core},
+			{"health_check", "health_check", "Current health status for the indicated instance: " + healthCheckHealthy + " refers to 'healthy', " + healthCheckUnhealthy + " refers to 'unhealthy'", s.gaugeMetric, false, core},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "health", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "health", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -61,27 +59,27 @@ func (s *LustreSysFsSource) generateHealthStatusTemplates(filter string) {
 func (s *LustreSysFsSource) generateOSTMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"obdfilter/*-OST*": {
-			{"degraded", "degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gaugeMetric, false, core},
-			{"grant_precreate", "grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", gaugeMetric, false, extended},
-			{"lfsck_speed_limit", "lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", gaugeMetric, false, extended},
-			{"precreate_batch", "precreate_batch", "Maximum number of objects that can be included in a single transaction", gaugeMetric, false, extended},
-			{"soft_sync_limit", "soft_sync_limit", "Number of RPCs necessary before triggering a sync", gaugeMetric, false, extended},
-			{"sync_journal", "sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", gaugeMetric, false, extended},
+			{"degraded", "degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", s.gaugeMetric, false, core},
+			{"grant_precreate", "grant_precreate_capacity_bytes", "Maximum space in bytes that clients can preallocate for objects", s.gaugeMetric, false, extended},
+			{"lfsck_speed_limit", "lfsck_speed_limit", "Maximum operations per second LFSCK (Lustre filesystem verification) can run", s.gaugeMetric, false, extended},
+			{"precreate_batch", "precreate_batch", "Maximum number of objects that can be included in a single transaction", s.gaugeMetric, false, extended},
+			{"soft_sync_limit", "soft_sync_limit", "Number of RPCs necessary before triggering a sync", s.gaugeMetric, false, extended},
+			{"sync_journal", "sync_journal_enabled", "Binary indicator as to whether or not the journal is set for asynchronous commits", s.gaugeMetric, false, extended},
 		},
 		"ldlm/namespaces/filter-*": {
-			{"lock_count", "lock_count", "Number of locks", gaugeMetric, false, extended},
-			{"lock_timeouts", "lock_timeout", "Number of lock timeouts", counterMetric, false, extended},
-			{"contended_locks", "lock_contended", "Number of contended locks", gaugeMetric, false, extended},
-			{"contention_seconds", "lock_contention_seconds", "Time in seconds during which locks were contended", gaugeMetric, false, extended},
-
-			{"pool/granted", "lock_granted", "Number of granted locks", gaugeMetric, false, extended},
-			{"pool/grant_plan", "lock_grant_plan", "Number of planned lock grants per second", gaugeMetric, false, extended},
-			{"pool/grant_rate", "lock_grant_rate", "Lock grant rate", gaugeMetric, false, extended},
+			{"lock_count", "lock_count", "Number of locks", s.gaugeMetric, false, extended},
+			{"lock_timeouts", "lock_timeout", "Number of lock timeouts", s.counterMetric, false, extended},
+			{"contended_locks", "lock_contended", "Number of contended locks", s.gaugeMetric, false, extended},
+			{"contention_seconds", "lock_contention_seconds", "Time in seconds during which locks were contended", s.gaugeMetric, false, extended},
+
+			{"pool/granted", "lock_granted", "Number of granted locks", s.gaugeMetric, false, extended},
+			{"pool/grant_plan", "lock_grant_plan", "Number of planned lock grants per second", s.gaugeMetric, false, extended},
+			{"pool/grant_rate", "lock_grant_rate", "Lock grant rate", s.gaugeMetric, false, extended},
 		},
 	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
-			if filter == extended || item.priorityLevel == core {
+			if s.config.templateSelected(filter, "ost", path, item) {
 				newMetric := newLustreProcMetric(item.filename, item.promName, "ost", path, item.helpText, item.hasMultipleVals, item.metricFunc)
 				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
 			}
@@ -89,62 +87,91 @@ func (s *LustreSysFsSource) generateOSTMetricTemplates(filter string) {
 	}
 }
 
-func newLustreSysFsSource() LustreSource {
+func newLustreSysFsSource(config Config) LustreSource {
 	var l LustreSysFsSource
-	l.basePath = filepath.Join(SysLocation, "fs/lustre")
-	if HealthStatusEnabled != disabled {
-		l.generateHealthStatusTemplates(HealthStatusEnabled)
+	l.config = config
+	l.telemetry = config.Telemetry
+	if config.Health != disabled {
+		l.generateHealthStatusTemplates(config.Health)
+	}
+	if config.Ost != disabled {
+		l.generateOSTMetricTemplates(config.Ost)
 	}
-	if OstEnabled != disabled {
-		l.generateOSTMetricTemplates(OstEnabled)
+	for _, metric := range l.lustreProcMetrics {
+		l.addDescriptor(metric.promName, metric.helpText, withTargetLabels()...)
 	}
 	return &l
 }
 
-func (s *LustreSysFsSource) Update(ch chan<- prometheus.Metric) (err error) {
+func (s *LustreSysFsSource) Update(ctx context.Context, ch chan<- prometheus.Metric) (err error) {
+	return s.UpdateSelected(ctx, ch, nil)
+}
+
+// Collectors returns the names of the collectors the source creates metrics for.
+func (s *LustreSysFsSource) Collectors() []string {
+	return templateCollectors(s.lustreProcMetrics)
+}
+
+func (s *LustreSysFsSource) UpdateSelected(ctx context.Context, ch chan<- prometheus.Metric, selection Selection) (err error) {
 	var directoryDepth int
 
+	_, layout := s.layouts.detect(s.config.Paths)
+	var errs collectionErrors
 	for _, metric := range s.lustreProcMetrics {
+		if !selection.selected(selectionCollector(metric.source, metric.filename)) {
+			continue
+		}
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
 		directoryDepth = strings.Count(metric.filename, "/")
-		paths, err := filepath.Glob(filepath.Join(s.basePath, metric.path, metric.filename))
+		pattern := filepath.Join(metric.path, metric.filename)
+		telemetry := s.config.Telemetry.newFileTelemetry(metric.source, pattern)
+		paths, err := globPaths(layout.sysfs, pattern)
 		if err != nil {
-			return err
+			telemetry.failed()
+			telemetry.done()
+			errs = append(errs, err)
+			continue
 		}
+		paths = s.config.TargetFilter.filterPaths(paths, directoryDepth)
+		telemetry.globbed(len(paths))
 		if paths == nil {
 			continue
 		}
 		for _, path := range paths {
 			switch metric.filename {
 			case "health_check":
-				err = s.parseTextFile(metric.source, "health_check", path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
-					ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
+				err = s.parseTextFile(telemetry, metric.source, "health_check", path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
+					labels, labelValues := targetLabels(nodeType, nodeName, nil)
+					sendMetric(ch, metric.metricFunc(labels, labelValues, name, helpText, value))
 				})
-				if err != nil {
-					return err
-				}
 			default:
-				err = s.parseFile(metric.source, single, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
-					} else {
-						ch <- metric.metricFunc([]string{"component", "target", extraLabel}, []string{nodeType, nodeName, extraLabelValue}, name, helpText, value)
+				err = s.parseFile(telemetry, metric.source, single, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					labels, labelValues := targetLabels(nodeType, nodeName, nil)
+					if extraLabelValue != "" {
+						labels, labelValues = append(labels, extraLabel), append(labelValues, extraLabelValue)
 					}
+					sendMetric(ch, metric.metricFunc(labels, labelValues, name, helpText, value))
 				})
-				if err != nil {
-					return err
-				}
+			}
+			if err != nil {
+				telemetry.failed()
+				errs = append(errs, fmt.Errorf("%s - %s", path, err))
 			}
 		}
+		telemetry.done()
 	}
-	return nil
+	return errs.err()
 }
 
-func (s *LustreSysFsSource) parseTextFile(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64)) (err error) {
+func (s *LustreSysFsSource) parseTextFile(telemetry *fileTelemetry, nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64)) (err error) {
 	filename, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
-	fileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	fileBytes, err := telemetry.readFile(path)
 	if err != nil {
 		return err
 	}
@@ -168,14 +195,14 @@ func (s *LustreSysFsSource) parseTextFile(nodeType string, metricType string, pa
 	return nil
 }
 
-func (s *LustreSysFsSource) parseFile(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, float64, string, string)) (err error) {
+func (s *LustreSysFsSource) parseFile(telemetry *fileTelemetry, nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, float64, string, string)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
 	switch metricType {
 	case single:
-		value, err := ioutil.ReadFile(filepath.Clean(path))
+		value, err := telemetry.readFile(path)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,244 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Telemetry contains the self-metrics of the files read and the metrics created by the sources of a config.
+// The files are labelled by the collector and the file pattern of the metric template.
+// It is a prometheus.Collector, which has to be registered by the user of the sources, e.g.
+//
+//	config := sources.DefaultConfig()
+//	prometheus.MustRegister(config.Telemetry)
+//
+// A nil Telemetry records nothing.
+type Telemetry struct {
+	fileReads            *prometheus.CounterVec
+	fileReadBytes        *prometheus.CounterVec
+	fileParseErrors      *prometheus.CounterVec
+	fileGlobMatches      *prometheus.GaugeVec
+	fileUp               *prometheus.GaugeVec
+	fileDurations        *prometheus.SummaryVec
+	sanitizedLabelValues *prometheus.CounterVec
+	rejectedMetrics      *prometheus.CounterVec
+}
+
+// NewTelemetry returns a new Telemetry without any recorded files or metrics.
+func NewTelemetry() *Telemetry {
+	return &Telemetry{
+		fileReads: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "files_read_total",
+				Help:      "lustre_exporter: Total number of files read by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		fileReadBytes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "read_bytes_total",
+				Help:      "lustre_exporter: Total number of bytes read by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		fileParseErrors: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "parse_errors_total",
+				Help:      "lustre_exporter: Total number of files that could not be read or parsed by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		fileGlobMatches: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "glob_matches",
+				Help:      "lustre_exporter: Number of files matching the file pattern during the last scrape by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		fileUp: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "file_up",
+				Help:      "lustre_exporter: Whether all files matching the file pattern were read and parsed during the last scrape by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		fileDurations: prometheus.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "file_duration_seconds",
+				Help:      "lustre_exporter: Duration of reading and parsing all files matching the file pattern by collector and file pattern.",
+			},
+			[]string{"collector", "file"},
+		),
+		sanitizedLabelValues: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "sanitized_label_values_total",
+				Help:      "lustre_exporter: Total number of label values with invalid UTF-8 that have been sanitized by metric.",
+			},
+			[]string{"metric"},
+		),
+		rejectedMetrics: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: "exporter",
+				Name:      "rejected_metrics_total",
+				Help:      "lustre_exporter: Total number of metrics that have been dropped by metric and reason.",
+			},
+			[]string{"metric", "reason"},
+		),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (t *Telemetry) Describe(ch chan<- *prometheus.Desc) {
+	if t == nil {
+		return
+	}
+	t.fileReads.Describe(ch)
+	t.fileReadBytes.Describe(ch)
+	t.fileParseErrors.Describe(ch)
+	t.fileGlobMatches.Describe(ch)
+	t.fileUp.Describe(ch)
+	t.fileDurations.Describe(ch)
+	t.sanitizedLabelValues.Describe(ch)
+	t.rejectedMetrics.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (t *Telemetry) Collect(ch chan<- prometheus.Metric) {
+	if t == nil {
+		return
+	}
+	t.fileReads.Collect(ch)
+	t.fileReadBytes.Collect(ch)
+	t.fileParseErrors.Collect(ch)
+	t.fileGlobMatches.Collect(ch)
+	t.fileUp.Collect(ch)
+	t.fileDurations.Collect(ch)
+	t.sanitizedLabelValues.Collect(ch)
+	t.rejectedMetrics.Collect(ch)
+}
+
+// sanitized records a label value of the metric that has been sanitized.
+func (t *Telemetry) sanitized(fqName string) {
+	if t == nil {
+		return
+	}
+	t.sanitizedLabelValues.WithLabelValues(fqName).Inc()
+}
+
+// rejected records a metric that has been dropped for the reason.
+func (t *Telemetry) rejected(fqName string, reason string) {
+	if t == nil {
+		return
+	}
+	t.rejectedMetrics.WithLabelValues(fqName, reason).Inc()
+}
+
+// fileTelemetry accounts the files read for a single metric template to the self-metrics.
+type fileTelemetry struct {
+	telemetry *Telemetry
+	collector string
+	file      string
+	begin     time.Time
+	failures  int
+}
+
+// newFileTelemetry returns the telemetry of a scrape of the metric template, which records nothing if t is nil.
+func (t *Telemetry) newFileTelemetry(collector string, file string) *fileTelemetry {
+	return &fileTelemetry{telemetry: t, collector: collector, file: file, begin: time.Now()}
+}
+
+// globbed records the number of files matching the file pattern.
+func (t *fileTelemetry) globbed(matches int) {
+	if t.telemetry == nil {
+		return
+	}
+	t.telemetry.fileGlobMatches.WithLabelValues(t.collector, t.file).Set(float64(matches))
+}
+
+// read records the content read from a file or command.
+func (t *fileTelemetry) read(content []byte) {
+	if t == nil || t.telemetry == nil {
+		return
+	}
+	t.telemetry.fileReads.WithLabelValues(t.collector, t.file).Inc()
+	t.telemetry.fileReadBytes.WithLabelValues(t.collector, t.file).Add(float64(len(content)))
+}
+
+// readFile reads the file and records it, the telemetry may be nil.
+func (t *fileTelemetry) readFile(path string) ([]byte, error) {
+	content, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	t.read(content)
+	return content, nil
+}
+
+// failed records a file that could not be read or parsed.
+func (t *fileTelemetry) failed() {
+	t.failures++
+	if t.telemetry == nil {
+		return
+	}
+	t.telemetry.fileParseErrors.WithLabelValues(t.collector, t.file).Inc()
+}
+
+// done records the duration since the telemetry has been created and whether any file failed.
+func (t *fileTelemetry) done() {
+	if t.telemetry == nil {
+		return
+	}
+	up := 1.0
+	if t.failures > 0 {
+		up = 0
+	}
+	t.telemetry.fileUp.WithLabelValues(t.collector, t.file).Set(up)
+	t.telemetry.fileDurations.WithLabelValues(t.collector, t.file).Observe(time.Since(t.begin).Seconds())
+}
+
+// collectionErrors contains the errors of all files that failed during a scrape.
+type collectionErrors []error
+
+func (e collectionErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e), strings.Join(messages, "; "))
+}
+
+// err returns nil if no error occurred.
+func (e collectionErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
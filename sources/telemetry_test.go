@@ -0,0 +1,208 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestFileTelemetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "telemetry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "num_exports")
+	if err := ioutil.WriteFile(path, []byte("12345\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewTelemetry()
+	telemetry := registry.newFileTelemetry("test", "obdfilter/*/num_exports")
+	telemetry.globbed(2)
+	for i := 0; i < 2; i++ {
+		if _, err := telemetry.readFile(path); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := telemetry.readFile(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("Expected an error reading a missing file")
+	}
+	telemetry.failed()
+	telemetry.done()
+
+	tests := map[string]float64{
+		"files_read_total":   testutil.ToFloat64(registry.fileReads.WithLabelValues("test", "obdfilter/*/num_exports")),
+		"read_bytes_total":   testutil.ToFloat64(registry.fileReadBytes.WithLabelValues("test", "obdfilter/*/num_exports")),
+		"parse_errors_total": testutil.ToFloat64(registry.fileParseErrors.WithLabelValues("test", "obdfilter/*/num_exports")),
+		"glob_matches":       testutil.ToFloat64(registry.fileGlobMatches.WithLabelValues("test", "obdfilter/*/num_exports")),
+	}
+	expected := map[string]float64{
+		"files_read_total":   2,
+		"read_bytes_total":   12,
+		"parse_errors_total": 1,
+		"glob_matches":       2,
+	}
+	for name, value := range expected {
+		if tests[name] != value {
+			t.Fatalf("Retrieved an unexpected value for %s. Expected: %g, Got: %g", name, value, tests[name])
+		}
+	}
+
+	if _, err := (*fileTelemetry)(nil).readFile(path); err != nil {
+		t.Fatalf("Unable to read file without telemetry: %s", err)
+	}
+	withoutTelemetry := (*Telemetry)(nil).newFileTelemetry("test", "obdfilter/*/num_exports")
+	withoutTelemetry.globbed(1)
+	if _, err := withoutTelemetry.readFile(path); err != nil {
+		t.Fatalf("Unable to read file without telemetry: %s", err)
+	}
+	withoutTelemetry.failed()
+	withoutTelemetry.done()
+}
+
+func TestPartialCollection(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partial")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"fs/lustre/health_check":                            "NOT HEALTHY\n",
+		"fs/lustre/obdfilter/lustrefs-OST0000/degraded":     "unexpected\n",
+		"fs/lustre/obdfilter/lustrefs-OST0001/degraded":     "0\n",
+		"fs/lustre/obdfilter/lustrefs-OST0001/sync_journal": "1\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := Config{Ost: extended, Health: extended, Telemetry: NewTelemetry()}
+	config.Paths = DefaultPaths()
+	config.Paths.Sys = dir
+
+	ch := make(chan prometheus.Metric, 100)
+	err = newLustreSysFsSource(config).Update(context.Background(), ch)
+	close(ch)
+	errs, ok := err.(collectionErrors)
+	if !ok || len(errs) != 1 {
+		t.Fatalf("Expected a single collection error, Got: %v", err)
+	}
+
+	var names []string
+	for metric := range ch {
+		names = append(names, metric.Desc().String())
+	}
+	if len(names) != 3 {
+		t.Fatalf("Retrieved an unexpected number of metrics. Expected: 3, Got: %d - %v", len(names), names)
+	}
+
+	if up := testutil.ToFloat64(config.Telemetry.fileUp.WithLabelValues("ost", "obdfilter/*-OST*/degraded")); up != 0 {
+		t.Fatalf("Expected the failed template to be down, Got: %g", up)
+	}
+	if up := testutil.ToFloat64(config.Telemetry.fileUp.WithLabelValues("ost", "obdfilter/*-OST*/sync_journal")); up != 1 {
+		t.Fatalf("Expected the parsed template to be up, Got: %g", up)
+	}
+}
+
+func TestConcurrentTelemetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "concurrent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := []string{
+		"fs/lustre/obdfilter/lustrefs-OST0000/degraded",
+		"fs/lustre/obdfilter/lustrefs-OST0000/sync_journal",
+	}
+	for _, name := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte("0\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := Config{Ost: extended, Telemetry: NewTelemetry()}
+	config.Paths = DefaultPaths()
+	config.Paths.Sys = dir
+	source := newLustreSysFsSource(config)
+
+	// Concurrent scrapes of a source account the files read to their own file pattern
+	patterns := []string{"obdfilter/*-OST*/degraded", "obdfilter/*-OST*/sync_journal"}
+	const scrapes = 10
+	var wg sync.WaitGroup
+	for i := 0; i < scrapes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ch := make(chan prometheus.Metric, 100)
+			if err := source.Update(context.Background(), ch); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	for _, pattern := range patterns {
+		if reads := testutil.ToFloat64(config.Telemetry.fileReads.WithLabelValues("ost", pattern)); reads != scrapes {
+			t.Fatalf("Retrieved an unexpected number of reads of %s. Expected: %d, Got: %g", pattern, scrapes, reads)
+		}
+	}
+}
+
+func TestIndependentTelemetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "independent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "fs/lustre/obdfilter/lustrefs-OST0000/degraded")
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte("0\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Sources created with different configs record their files to their own telemetry only
+	scraped := Config{Ost: extended, Telemetry: NewTelemetry()}
+	scraped.Paths = DefaultPaths()
+	scraped.Paths.Sys = dir
+	idle := scraped
+	idle.Telemetry = NewTelemetry()
+	newLustreSysFsSource(idle)
+	ch := make(chan prometheus.Metric, 100)
+	if err := newLustreSysFsSource(scraped).Update(context.Background(), ch); err != nil {
+		t.Fatal(err)
+	}
+
+	pattern := "obdfilter/*-OST*/degraded"
+	if reads := testutil.ToFloat64(scraped.Telemetry.fileReads.WithLabelValues("ost", pattern)); reads != 1 {
+		t.Fatalf("Retrieved an unexpected number of reads of %s. Expected: 1, Got: %g", pattern, reads)
+	}
+	if count := testutil.CollectAndCount(idle.Telemetry); count != 0 {
+		t.Fatalf("Retrieved an unexpected number of self-metrics of the idle config. Expected: 0, Got: %d", count)
+	}
+}
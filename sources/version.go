@@ -0,0 +1,192 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var versionRegexPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.\d+)?\S*`)
+
+// lustreLayout contains the base paths each source searches for its files with a given Lustre version.
+// Files are looked up in the order of the base paths, the first base path containing a file is used.
+type lustreLayout struct {
+	version string // minimum Lustre version the layout applies to
+	procfs  []string
+	sysfs   []string
+	lnet    []string
+}
+
+// lustreLayouts returns the known path layouts ordered from the newest to the oldest Lustre version.
+func lustreLayouts(paths Paths) []lustreLayout {
+	proc := filepath.Join(paths.Proc, "fs/lustre")
+	sys := filepath.Join(paths.Sys, "fs/lustre")
+	debug := paths.Debug
+	return []lustreLayout{
+		// Lustre 2.15 moved most of the statistics to debugfs
+		{version: "2.15", procfs: []string{proc, filepath.Join(debug, "lustre"), sys}, sysfs: []string{sys}, lnet: []string{debug}},
+		// Lustre 2.12 moved most of the tunables from procfs to sysfs
+		{version: "2.12", procfs: []string{proc, sys, filepath.Join(debug, "lustre")}, sysfs: []string{sys}, lnet: []string{debug}},
+		// Lustre 2.10 still provides part of the health and LNet files in procfs only
+		{version: "2.10", procfs: []string{proc}, sysfs: []string{sys, proc}, lnet: []string{debug, filepath.Join(paths.Proc, "sys")}},
+	}
+}
+
+// DetectLustreVersion returns the version of the running Lustre modules.
+func DetectLustreVersion(paths Paths) (string, error) {
+	var errs []string
+	for _, path := range versionPaths(paths) {
+		content, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		version, err := parseLustreVersion(string(content))
+		if err != nil {
+			return "", fmt.Errorf("%s - %s", path, err)
+		}
+		return version, nil
+	}
+	return "", fmt.Errorf("no Lustre version found: %s", strings.Join(errs, ", "))
+}
+
+// versionPaths returns the paths of the 'version' file in the order they are read.
+func versionPaths(paths Paths) []string {
+	return []string{
+		filepath.Join(paths.Sys, "fs/lustre/version"),
+		filepath.Join(paths.Proc, "fs/lustre/version"),
+	}
+}
+
+// parseLustreVersion extracts the version from the 'version' file, which contains either just the version
+// or with older Lustre versions a line in the format 'lustre: <version>'.
+func parseLustreVersion(content string) (string, error) {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 1 && versionRegexPattern.MatchString(fields[0]) {
+			return fields[0], nil
+		}
+		if len(fields) == 2 && fields[0] == "lustre:" {
+			return fields[1], nil
+		}
+	}
+	return "", fmt.Errorf("unexpected version format: %q", strings.TrimSpace(content))
+}
+
+// compareLustreVersions compares the major and minor version of a and b
+// and returns a negative number if a is older, 0 if they match and a positive number if a is newer.
+func compareLustreVersions(a string, b string) (int, error) {
+	matchA, matchB := versionRegexPattern.FindStringSubmatch(a), versionRegexPattern.FindStringSubmatch(b)
+	if matchA == nil || matchB == nil {
+		return 0, fmt.Errorf("unable to compare versions %q and %q", a, b)
+	}
+	for i := 1; i <= 2; i++ {
+		numberA, err := strconv.Atoi(matchA[i])
+		if err != nil {
+			return 0, err
+		}
+		numberB, err := strconv.Atoi(matchB[i])
+		if err != nil {
+			return 0, err
+		}
+		if numberA != numberB {
+			return numberA - numberB, nil
+		}
+	}
+	return 0, nil
+}
+
+// selectLustreLayout returns the path layout for the given Lustre version.
+// The layout of the oldest supported version is used if the version is unknown.
+func selectLustreLayout(paths Paths, version string) lustreLayout {
+	layouts := lustreLayouts(paths)
+	for _, layout := range layouts {
+		result, err := compareLustreVersions(version, layout.version)
+		if err != nil {
+			break
+		}
+		if result >= 0 {
+			return layout
+		}
+	}
+	return layouts[len(layouts)-1]
+}
+
+// detectLustreLayout returns the path layout for the running Lustre version.
+func detectLustreLayout(paths Paths) lustreLayout {
+	version, err := DetectLustreVersion(paths)
+	if err != nil {
+		log.Debugf("Unable to detect Lustre version, using default path layout: %s", err)
+	}
+	layout := selectLustreLayout(paths, version)
+	log.Debugf("Using path layout of Lustre %s for version %q", layout.version, version)
+	return layout
+}
+
+// layoutCache keeps the Lustre version and path layout detected by a source,
+// so the version files are not read again on every scrape.
+// A failed detection is not kept, e.g. if the Lustre modules are loaded after the exporter started.
+type layoutCache struct {
+	mu      sync.Mutex
+	version string
+	layout  *lustreLayout
+}
+
+// detect returns the version and path layout of the running Lustre, the version is empty if it is unknown.
+func (c *layoutCache) detect(paths Paths) (string, lustreLayout) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.layout != nil {
+		return c.version, *c.layout
+	}
+	version, err := DetectLustreVersion(paths)
+	layout := selectLustreLayout(paths, version)
+	if err != nil {
+		log.Debugf("Unable to detect Lustre version, using default path layout: %s", err)
+		return "", layout
+	}
+	log.Debugf("Using path layout of Lustre %s for version %q", layout.version, version)
+	c.version, c.layout = version, &layout
+	return version, layout
+}
+
+// globPaths returns the paths matching the pattern within the first base path containing any match.
+func globPaths(basePaths []string, pattern string) ([]string, error) {
+	for _, basePath := range basePaths {
+		paths, err := filepath.Glob(filepath.Join(basePath, pattern))
+		if err != nil {
+			return nil, err
+		}
+		if paths != nil {
+			return paths, nil
+		}
+	}
+	return nil, nil
+}
+
+// findPath returns the path of the file within the first base path containing it.
+// If no base path contains the file, the path within the first base path is returned.
+func findPath(basePaths []string, name string) string {
+	for _, basePath := range basePaths {
+		path := filepath.Join(basePath, name)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return filepath.Join(basePaths[0], name)
+}
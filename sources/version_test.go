@@ -0,0 +1,89 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package sources
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseLustreVersion(t *testing.T) {
+	tests := map[string]string{
+		"2.10.1\n":            "2.10.1",
+		"2.15.3_4_g1e8b5f1\n": "2.15.3_4_g1e8b5f1",
+		"lustre: 2.12.8\nkernel: patchless_client\nbuild: 2.12.8_ddn10\n": "2.12.8",
+	}
+	for content, expected := range tests {
+		version, err := parseLustreVersion(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version != expected {
+			t.Fatalf("Retrieved an unexpected version. Expected: %s, Got: %s", expected, version)
+		}
+	}
+
+	if version, err := parseLustreVersion("unknown\n"); err == nil {
+		t.Fatalf("Unexpected version parsed: %s", version)
+	}
+}
+
+func TestSelectLustreLayout(t *testing.T) {
+	tests := map[string]string{
+		"2.10.1":            "2.10",
+		"2.11.0":            "2.10",
+		"2.12.8":            "2.12",
+		"2.14.0":            "2.12",
+		"2.15.3_4_g1e8b5f1": "2.15",
+		"2.16.0":            "2.15",
+		"3.0.0":             "2.15",
+		"2.9.0":             "2.10",
+		"":                  "2.10",
+	}
+	for version, expected := range tests {
+		if layout := selectLustreLayout(DefaultPaths(), version); layout.version != expected {
+			t.Fatalf("Retrieved an unexpected layout for version %q. Expected: %s, Got: %s", version, expected, layout.version)
+		}
+	}
+}
+
+func TestLayoutCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	paths := Paths{Proc: filepath.Join(dir, "proc"), Sys: filepath.Join(dir, "sys"), Debug: filepath.Join(dir, "debug")}
+	versionPath := filepath.Join(paths.Sys, "fs/lustre/version")
+
+	// A failed detection is retried on the next scrape
+	var cache layoutCache
+	if version, layout := cache.detect(paths); version != "" || layout.version != "2.10" {
+		t.Fatalf("Retrieved an unexpected layout without a version file. Expected: 2.10, Got: %s (%q)", layout.version, version)
+	}
+	if err := os.MkdirAll(filepath.Dir(versionPath), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(versionPath, []byte("2.15.3\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if version, layout := cache.detect(paths); version != "2.15.3" || layout.version != "2.15" {
+		t.Fatalf("Retrieved an unexpected layout. Expected: 2.15, Got: %s (%q)", layout.version, version)
+	}
+
+	// A detected version is kept without reading the version file again
+	if err := os.Remove(versionPath); err != nil {
+		t.Fatal(err)
+	}
+	if version, layout := cache.detect(paths); version != "2.15.3" || layout.version != "2.15" {
+		t.Fatalf("Retrieved an unexpected cached layout. Expected: 2.15, Got: %s (%q)", layout.version, version)
+	}
+}
@@ -0,0 +1,88 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	log "github.com/sirupsen/logrus"
+)
+
+// gatherOnce collects all metrics of the collector once, including the scrape durations and results.
+// The sources are abandoned after the timeout, zero for none.
+// The metrics gathered are returned along with an error of the gathering.
+func gatherOnce(collector selectableCollector, timeout time.Duration) ([]*dto.MetricFamily, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	registry := prometheus.NewRegistry()
+	if err := registry.Register(selectedCollector{ctx: ctx, collector: collector}); err != nil {
+		return nil, fmt.Errorf("unable to register sources: %s", err)
+	}
+	return registry.Gather()
+}
+
+// writeTextfile collects all metrics of the collector once and writes them in the text format to the file,
+// e.g. into the textfile directory of the node_exporter.
+func writeTextfile(collector selectableCollector, path string, timeout time.Duration) error {
+	families, err := gatherOnce(collector, timeout)
+	if err != nil {
+		if len(families) == 0 {
+			return err
+		}
+		log.Errorf("Writing the metrics gathered despite errors: %s", err)
+	}
+	return writeFileAtomic(path, func(f *os.File) error {
+		for _, family := range families {
+			if _, err := expfmt.MetricFamilyToText(f, family); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// writeFileAtomic writes the file by renaming a temporary file within the same directory, so readers never see a partial file.
+// The temporary file is hidden and has no '.prom' suffix, so the node_exporter ignores it.
+func writeFileAtomic(path string, write func(f *os.File) error) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// The temporary file is gone after the rename
+	defer func() { _ = os.Remove(f.Name()) }()
+	if err := write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	// The file is read by other users like the node_exporter
+	if err := f.Chmod(0644); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
@@ -0,0 +1,106 @@
+// -*- coding: utf-8 -*-
+//
+// © Copyright 2023 GSI Helmholtzzentrum für Schwerionenforschung
+//
+// This software is distributed under
+// the terms of the GNU General Public Licence version 3 (GPL Version 3),
+// copied verbatim in the file "LICENCE".
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+)
+
+func TestWriteTextfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := fixtureConfig()
+	sourceList, errList := loadSources([]string{"procfs", "sys", "sysfs", "lctl"}, config)
+	if errList != nil {
+		t.Fatal(errList)
+	}
+	path := filepath.Join(dir, "lustre.prom")
+	if err := ioutil.WriteFile(path, []byte("outdated"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeTextfile(LustreSource{sourceList: sourceList, telemetry: config.Telemetry}, path, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	families, err := new(expfmt.TextParser).TextToMetricFamilies(file)
+	if err != nil {
+		t.Fatalf("Unable to parse the written textfile: %s", err)
+	}
+	for _, name := range []string{"lustre_job_stats_total", "lustre_exporter_scrape_duration_seconds", "lustre_exporter_files_read_total"} {
+		if _, exists := families[name]; !exists {
+			t.Fatalf("Retrieved no %s from the textfile", name)
+		}
+	}
+	if _, exists := families["go_goroutines"]; exists {
+		t.Fatal("Retrieved the Go runtime metrics of the exporter from the textfile")
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Mode().Perm() != 0644 {
+		t.Fatalf("Retrieved unexpected files after the write. Expected: [lustre.prom] with mode 0644, Got: %v", files)
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lustre_exporter_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "lustre.prom")
+	if err := ioutil.WriteFile(path, []byte("previous"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = writeFileAtomic(path, func(f *os.File) error {
+		if _, err := f.WriteString("partial"); err != nil {
+			return err
+		}
+		return errors.New("write failed")
+	})
+	if err == nil {
+		t.Fatal("An error was expected for a failed write, but not received")
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "previous" {
+		t.Fatalf("Retrieved unexpected content after a failed write. Expected: %q, Got: %q", "previous", content)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".tmp") {
+			t.Fatalf("Retrieved a temporary file left after a failed write: %s", file.Name())
+		}
+	}
+}